@@ -19,6 +19,12 @@ func main() {
 	testConn := flag.Bool("test", false, "Test AWS connection without starting TUI")
 	noAltScreen := flag.Bool("no-alt-screen", false, "Disable alternate screen (allows text selection/copy)")
 	themeFlag := flag.String("theme", "auto", "Color theme: auto, dark, or light")
+	endpointURL := flag.String("endpoint-url", "", "Custom AWS endpoint URL for all services (e.g. LocalStack)")
+	noVerifySSL := flag.Bool("no-verify-ssl", false, "Skip TLS certificate verification (for LocalStack)")
+	initConfig := flag.Bool("init", false, "Run the configuration wizard and exit")
+	reconfigure := flag.Bool("reconfigure", false, "Re-run the configuration wizard and exit")
+	validateConfig := flag.Bool("validate-config", false, "Validate ~/.vaws/config.yaml and exit")
+	jsonOut := flag.String("json", "", "Print a resource listing as JSON and exit (stacks, clusters, functions, queues, tables, apis)")
 
 	// Custom usage
 	flag.Usage = func() {
@@ -43,6 +49,22 @@ func main() {
 		return
 	}
 
+	if *validateConfig {
+		if err := app.ValidateConfig(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if *initConfig || *reconfigure {
+		if err := app.RunConfigWizard(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	if *listProfiles {
 		if err := app.PrintProfiles(); err != nil {
 			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
@@ -58,6 +80,17 @@ func main() {
 		Debug:       *debug,
 		NoAltScreen: *noAltScreen,
 		Theme:       *themeFlag,
+		EndpointURL: *endpointURL,
+		NoVerifySSL: *noVerifySSL,
+	}
+
+	// Headless JSON mode for scripting
+	if *jsonOut != "" {
+		if err := app.PrintJSON(cfg, *jsonOut); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
 	}
 
 	// Test connection mode
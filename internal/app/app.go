@@ -10,6 +10,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 
 	"vaws/internal/aws"
+	"vaws/internal/config"
 	"vaws/internal/log"
 	"vaws/internal/ui"
 	"vaws/internal/ui/theme"
@@ -23,10 +24,52 @@ type Config struct {
 	NoAltScreen bool     // Disable alternate screen for easier copy/paste
 	Profiles    []string // Available AWS profiles (populated if no profile specified)
 	Theme       string   // Theme override: "auto", "dark", or "light"
+	EndpointURL string   // Custom AWS endpoint URL (LocalStack / VPC endpoints)
+	NoVerifySSL bool     // Skip TLS certificate verification (for LocalStack)
+}
+
+// applyEndpointOverride validates and installs the endpoint override, failing
+// fast if the endpoint is unreachable so the TUI never starts half-broken.
+func applyEndpointOverride(cfg Config) error {
+	if cfg.EndpointURL == "" {
+		return nil
+	}
+	if err := aws.SetEndpointOverride(cfg.EndpointURL, cfg.NoVerifySSL); err != nil {
+		return err
+	}
+	if err := aws.CheckEndpoint(5 * time.Second); err != nil {
+		return err
+	}
+	return nil
 }
 
 // Run starts the application with the given configuration.
 func Run(cfg Config) error {
+	// First-run wizard: walk through config setup when none exists yet
+	if !config.Exists() && isInteractive() {
+		if err := RunConfigWizard(); err != nil {
+			return err
+		}
+	}
+
+	// Fall back to the configured default profile and theme when none were
+	// passed, and apply the retry policy before any client makes calls
+	if c, err := config.Load(); err == nil {
+		if cfg.Profile == "" && c.Defaults.Profile != "" {
+			cfg.Profile = c.Defaults.Profile
+		}
+		if (cfg.Theme == "" || cfg.Theme == "auto") && c.Defaults.Theme != "" {
+			cfg.Theme = c.Defaults.Theme
+		}
+		aws.SetRetryPolicy(c.Retry.MaxAttempts, time.Duration(c.Retry.BaseDelayMillis)*time.Millisecond)
+		aws.SetDescribeCacheTTL(time.Duration(c.Defaults.DescribeCacheTTLSeconds) * time.Second)
+	}
+
+	// Apply endpoint override before any client is constructed
+	if err := applyEndpointOverride(cfg); err != nil {
+		return err
+	}
+
 	// Initialize theme
 	switch cfg.Theme {
 	case "dark":
@@ -127,8 +170,36 @@ func MustRun(cfg Config) {
 	}
 }
 
+// ValidateConfig loads the configuration exactly as startup does and prints
+// every problem found with the offending key and a suggestion. Returns an
+// error when the config is invalid so callers can exit non-zero.
+func ValidateConfig() error {
+	path := config.DefaultConfigPath()
+
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load %s: %w", path, err)
+	}
+
+	errs := cfg.Validate()
+	if len(errs) == 0 {
+		fmt.Printf("%s: OK\n", path)
+		return nil
+	}
+
+	fmt.Printf("%s: %d problem(s)\n", path, len(errs))
+	for _, e := range errs {
+		fmt.Printf("  - %v\n", e)
+	}
+	return fmt.Errorf("config validation failed")
+}
+
 // TestConnection tests AWS connectivity by attempting to list stacks.
 func TestConnection(cfg Config) error {
+	if err := applyEndpointOverride(cfg); err != nil {
+		return err
+	}
+
 	fmt.Printf("Testing AWS connection...\n")
 	fmt.Printf("  Profile: %s\n", cfg.Profile)
 	fmt.Printf("  Region:  %s\n", cfg.Region)
@@ -0,0 +1,68 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"vaws/internal/aws"
+	"vaws/internal/config"
+)
+
+// PrintJSON runs the lister for resource and writes the resulting model
+// slice as JSON to stdout, for scripting against vaws's AWS layer without
+// the TUI. Errors go to the caller so main can exit non-zero.
+func PrintJSON(cfg Config, resource string) error {
+	// Same profile fallback and endpoint handling as the TUI path
+	if cfg.Profile == "" {
+		if c, err := config.Load(); err == nil && c.Defaults.Profile != "" {
+			cfg.Profile = c.Defaults.Profile
+		}
+	}
+	if err := applyEndpointOverride(cfg); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
+	defer cancel()
+
+	client, err := aws.NewClient(ctx, cfg.Profile, cfg.Region)
+	if err != nil {
+		return fmt.Errorf("failed to create AWS client: %w", err)
+	}
+
+	var data interface{}
+	switch resource {
+	case "stacks":
+		data, err = client.ListStacks(ctx)
+	case "clusters":
+		data, err = client.ListClusters(ctx)
+	case "functions":
+		data, err = client.ListFunctions(ctx)
+	case "queues":
+		data, err = client.ListQueues(ctx)
+	case "tables":
+		data, err = client.ListTables(ctx)
+	case "apis":
+		rest, restErr := client.ListRestAPIs(ctx)
+		if restErr != nil {
+			return restErr
+		}
+		http, httpErr := client.ListHttpAPIs(ctx)
+		if httpErr != nil {
+			return httpErr
+		}
+		data = map[string]interface{}{"rest": rest, "http": http}
+	default:
+		return fmt.Errorf("unknown resource %q (use stacks, clusters, functions, queues, tables, apis)", resource)
+	}
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(data)
+}
@@ -0,0 +1,167 @@
+package app
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"vaws/internal/aws"
+	"vaws/internal/config"
+)
+
+// RunConfigWizard interactively builds ~/.vaws/config.yaml, walking through
+// the default profile, region, jump-host discovery settings, and VPC endpoint
+// id, then writes a commented config file. Existing values are offered as
+// defaults so the wizard doubles as an editor on reconfigure.
+func RunConfigWizard() error {
+	existing, err := config.Load()
+	if err != nil || existing == nil {
+		existing = &config.Config{}
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+
+	fmt.Println("vaws configuration wizard")
+	fmt.Printf("Writing to %s - press Enter to accept defaults.\n\n", config.DefaultConfigPath())
+
+	// Default profile
+	if profiles, err := aws.ListProfiles(); err == nil && len(profiles) > 0 {
+		fmt.Println("Available AWS profiles:")
+		for _, p := range profiles {
+			fmt.Printf("  - %s\n", p)
+		}
+	}
+	profile := promptString(reader, "Default profile (optional)", existing.Defaults.Profile)
+
+	// Default region for that profile
+	regionDefault := ""
+	if profile != "" {
+		regionDefault = existing.GetProfileConfig(profile).Region
+	}
+	region := promptValidated(reader, "Default region (optional)", regionDefault, func(v string) error {
+		if !config.IsValidRegion(v) {
+			return fmt.Errorf("%q does not look like an AWS region (e.g. eu-west-1)", v)
+		}
+		return nil
+	})
+
+	// Jump host discovery
+	tagsDefault := strings.Join(existing.Defaults.JumpHostTags, ",")
+	tags := splitCommaList(promptString(reader, "Jump host tags (comma separated, Key=Value)", tagsDefault))
+
+	namesDefault := strings.Join(existing.Defaults.JumpHostNames, ",")
+	names := splitCommaList(promptString(reader, "Jump host instance names (comma separated)", namesDefault))
+
+	// VPC endpoint for private API Gateway access
+	vpceDefault := ""
+	if profile != "" {
+		vpceDefault = existing.GetProfileConfig(profile).VPCEndpointID
+	}
+	vpce := promptValidated(reader, "VPC endpoint ID for private API Gateway (optional)", vpceDefault, func(v string) error {
+		if !config.IsValidVPCEndpointID(v) {
+			return fmt.Errorf("%q does not look like a VPC endpoint id (vpce-...)", v)
+		}
+		return nil
+	})
+
+	// Build a commented config file rather than bare yaml.Marshal output
+	var b strings.Builder
+	b.WriteString("# vaws configuration\n")
+	b.WriteString("# Generated by the configuration wizard - edit freely.\n\n")
+	b.WriteString("defaults:\n")
+	if profile != "" {
+		b.WriteString("  # Profile used when --profile is not passed\n")
+		b.WriteString(fmt.Sprintf("  profile: %s\n", profile))
+	}
+	if len(tags) > 0 {
+		b.WriteString("  # Tags searched when auto-discovering jump hosts (first match wins)\n")
+		b.WriteString("  jump_host_tags:\n")
+		for _, t := range tags {
+			b.WriteString(fmt.Sprintf("    - %q\n", t))
+		}
+	}
+	if len(names) > 0 {
+		b.WriteString("  # Instance names searched when auto-discovering jump hosts\n")
+		b.WriteString("  jump_host_names:\n")
+		for _, n := range names {
+			b.WriteString(fmt.Sprintf("    - %s\n", n))
+		}
+	}
+	if profile != "" && (region != "" || vpce != "") {
+		b.WriteString("\nprofiles:\n")
+		b.WriteString(fmt.Sprintf("  %s:\n", profile))
+		if region != "" {
+			b.WriteString("    # Default region for this profile\n")
+			b.WriteString(fmt.Sprintf("    region: %s\n", region))
+		}
+		if vpce != "" {
+			b.WriteString("    # VPC endpoint for cross-account private API Gateway access\n")
+			b.WriteString(fmt.Sprintf("    vpc_endpoint_id: %s\n", vpce))
+		}
+	}
+
+	path := config.DefaultConfigPath()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	if err := os.WriteFile(path, []byte(b.String()), 0644); err != nil {
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+
+	fmt.Printf("\nWrote %s\n", path)
+	return nil
+}
+
+// isInteractive reports whether stdin is attached to a terminal.
+func isInteractive() bool {
+	info, err := os.Stdin.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// promptString asks for a value, returning def when the user just hits Enter.
+func promptString(r *bufio.Reader, label, def string) string {
+	if def != "" {
+		fmt.Printf("%s [%s]: ", label, def)
+	} else {
+		fmt.Printf("%s: ", label)
+	}
+	line, _ := r.ReadString('\n')
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return def
+	}
+	return line
+}
+
+// promptValidated re-asks until the value passes validate. Empty input skips
+// the field.
+func promptValidated(r *bufio.Reader, label, def string, validate func(string) error) string {
+	for {
+		v := promptString(r, label, def)
+		if v == "" {
+			return v
+		}
+		if err := validate(v); err != nil {
+			fmt.Printf("  %v\n", err)
+			def = "" // don't re-offer a default the user already overrode
+			continue
+		}
+		return v
+	}
+}
+
+// splitCommaList splits a comma-separated prompt answer into trimmed entries.
+func splitCommaList(s string) []string {
+	var out []string
+	for _, part := range strings.Split(s, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
@@ -0,0 +1,51 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+
+	"vaws/internal/log"
+	"vaws/internal/model"
+)
+
+// GetAlarmsForResource queries CloudWatch alarms configured on a metric for
+// the given dimensions (e.g. AWS/SQS ApproximateNumberOfMessagesVisible with
+// QueueName=orders).
+func (c *Client) GetAlarmsForResource(ctx context.Context, namespace, metricName string, dimensions map[string]string) ([]model.Alarm, error) {
+	log.Debug("Describing alarms for %s/%s %v", namespace, metricName, dimensions)
+
+	var dims []cwtypes.Dimension
+	for name, value := range dimensions {
+		dims = append(dims, cwtypes.Dimension{
+			Name:  aws.String(name),
+			Value: aws.String(value),
+		})
+	}
+
+	out, err := c.cw.DescribeAlarmsForMetric(ctx, &cloudwatch.DescribeAlarmsForMetricInput{
+		Namespace:  aws.String(namespace),
+		MetricName: aws.String(metricName),
+		Dimensions: dims,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe alarms for %s/%s: %w", namespace, metricName, err)
+	}
+
+	var alarms []model.Alarm
+	for _, a := range out.MetricAlarms {
+		alarms = append(alarms, model.Alarm{
+			Name:               aws.ToString(a.AlarmName),
+			State:              string(a.StateValue),
+			StateReason:        aws.ToString(a.StateReason),
+			MetricName:         aws.ToString(a.MetricName),
+			Namespace:          aws.ToString(a.Namespace),
+			Threshold:          aws.ToFloat64(a.Threshold),
+			ComparisonOperator: string(a.ComparisonOperator),
+		})
+	}
+	return alarms, nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	apigwtypes "github.com/aws/aws-sdk-go-v2/service/apigateway/types"
 	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
 
 	"vaws/internal/model"
@@ -51,6 +52,12 @@ func (c *Client) ListRestAPIs(ctx context.Context) ([]model.RestAPI, error) {
 
 // GetRestAPI returns a single REST API by ID.
 func (c *Client) GetRestAPI(ctx context.Context, apiID string) (*model.RestAPI, error) {
+	cacheKey := "apigw:rest:" + apiID
+	if cached, ok := c.describes.get(cacheKey); ok {
+		api := cached.(model.RestAPI)
+		return &api, nil
+	}
+
 	out, err := c.apigw.GetRestApi(ctx, &apigateway.GetRestApiInput{
 		RestApiId: aws.String(apiID),
 	})
@@ -67,7 +74,7 @@ func (c *Client) GetRestAPI(ctx context.Context, apiID string) (*model.RestAPI,
 		vpcEndpointIds = out.EndpointConfiguration.VpcEndpointIds
 	}
 
-	return &model.RestAPI{
+	api := model.RestAPI{
 		ID:             aws.ToString(out.Id),
 		Name:           aws.ToString(out.Name),
 		Description:    aws.ToString(out.Description),
@@ -75,7 +82,9 @@ func (c *Client) GetRestAPI(ctx context.Context, apiID string) (*model.RestAPI,
 		EndpointType:   endpointType,
 		Version:        aws.ToString(out.Version),
 		VpcEndpointIds: vpcEndpointIds,
-	}, nil
+	}
+	c.describes.put(cacheKey, api)
+	return &api, nil
 }
 
 // GetRestAPIStages returns the stages for a REST API.
@@ -99,6 +108,7 @@ func (c *Client) GetRestAPIStages(ctx context.Context, apiID string) ([]model.AP
 			CreatedDate:  aws.ToTime(s.CreatedDate),
 			LastUpdated:  aws.ToTime(s.LastUpdatedDate),
 			InvokeURL:    invokeURL,
+			Variables:    s.Variables,
 		})
 	}
 
@@ -176,6 +186,7 @@ func (c *Client) GetHttpAPIStages(ctx context.Context, apiID string) ([]model.AP
 			DeploymentID: aws.ToString(s.DeploymentId),
 			CreatedDate:  aws.ToTime(s.CreatedDate),
 			LastUpdated:  aws.ToTime(s.LastUpdatedDate),
+			Variables:    s.StageVariables,
 		})
 	}
 
@@ -208,3 +219,88 @@ func (c *Client) GetHttpAPIRoutes(ctx context.Context, apiID string) ([]model.AP
 
 	return routes, nil
 }
+
+// ExportRestAPI exports a REST API stage definition via GetExport.
+// format is "oas30" or "swagger"; asYAML selects YAML over JSON output.
+func (c *Client) ExportRestAPI(ctx context.Context, apiID, stageName, format string, asYAML bool) ([]byte, error) {
+	accepts := "application/json"
+	if asYAML {
+		accepts = "application/yaml"
+	}
+
+	out, err := c.apigw.GetExport(ctx, &apigateway.GetExportInput{
+		RestApiId:  aws.String(apiID),
+		StageName:  aws.String(stageName),
+		ExportType: aws.String(format),
+		Accepts:    aws.String(accepts),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to export REST API %s: %w", apiID, err)
+	}
+	return out.Body, nil
+}
+
+// ExportHTTPAPI exports an HTTP API's OpenAPI 3 definition via ExportApi.
+func (c *Client) ExportHTTPAPI(ctx context.Context, apiID, stageName string, asYAML bool) ([]byte, error) {
+	outputType := "JSON"
+	if asYAML {
+		outputType = "YAML"
+	}
+
+	out, err := c.apigwv2.ExportApi(ctx, &apigatewayv2.ExportApiInput{
+		ApiId:         aws.String(apiID),
+		Specification: aws.String("OAS30"),
+		OutputType:    aws.String(outputType),
+		StageName:     aws.String(stageName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to export HTTP API %s: %w", apiID, err)
+	}
+	return out.Body, nil
+}
+
+// UpdateRestStageVariable patches one stage variable on a REST API stage.
+func (c *Client) UpdateRestStageVariable(ctx context.Context, apiID, stageName, key, value string) error {
+	_, err := c.apigw.UpdateStage(ctx, &apigateway.UpdateStageInput{
+		RestApiId: aws.String(apiID),
+		StageName: aws.String(stageName),
+		PatchOperations: []apigwtypes.PatchOperation{{
+			Op:    apigwtypes.OpReplace,
+			Path:  aws.String("/variables/" + key),
+			Value: aws.String(value),
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update stage variable %s: %w", key, err)
+	}
+	return nil
+}
+
+// UpdateHTTPStageVariable sets one stage variable on an HTTP API stage.
+// UpdateStage replaces the entire variables map, so the current variables
+// are fetched first and merged to avoid wiping the others.
+func (c *Client) UpdateHTTPStageVariable(ctx context.Context, apiID, stageName, key, value string) error {
+	current, err := c.apigwv2.GetStage(ctx, &apigatewayv2.GetStageInput{
+		ApiId:     aws.String(apiID),
+		StageName: aws.String(stageName),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to read stage %s: %w", stageName, err)
+	}
+
+	variables := make(map[string]string, len(current.StageVariables)+1)
+	for k, v := range current.StageVariables {
+		variables[k] = v
+	}
+	variables[key] = value
+
+	_, err = c.apigwv2.UpdateStage(ctx, &apigatewayv2.UpdateStageInput{
+		ApiId:          aws.String(apiID),
+		StageName:      aws.String(stageName),
+		StageVariables: variables,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update stage variable %s: %w", key, err)
+	}
+	return nil
+}
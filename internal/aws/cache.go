@@ -0,0 +1,87 @@
+package aws
+
+import (
+	"sync"
+	"time"
+)
+
+// defaultDescribeCacheTTL is how long describe results are served from
+// memory when defaults.describe_cache_ttl_seconds is not configured.
+const defaultDescribeCacheTTL = 60 * time.Second
+
+var describeCacheTTL = defaultDescribeCacheTTL
+
+// SetDescribeCacheTTL configures the describe-cache TTL from config.
+// Zero keeps the built-in default; negative disables caching.
+func SetDescribeCacheTTL(ttl time.Duration) {
+	if ttl != 0 {
+		describeCacheTTL = ttl
+	}
+}
+
+// describeCache memoizes describe-style results (queue attributes, function
+// configs, API details) so re-entering a view within the TTL doesn't
+// re-fetch everything. The cache lives on the Client, so a profile or
+// region switch - which always builds a new Client - starts empty.
+type describeCache struct {
+	mu      sync.Mutex
+	entries map[string]describeEntry
+}
+
+type describeEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+// get returns a cached value that hasn't expired.
+func (d *describeCache) get(key string) (interface{}, bool) {
+	if describeCacheTTL < 0 {
+		return nil, false
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	entry, ok := d.entries[key]
+	if !ok || time.Now().After(entry.expires) {
+		delete(d.entries, key)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+// put stores a value until the TTL elapses.
+func (d *describeCache) put(key string, value interface{}) {
+	if describeCacheTTL < 0 {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.entries == nil {
+		d.entries = make(map[string]describeEntry)
+	}
+	d.entries[key] = describeEntry{value: value, expires: time.Now().Add(describeCacheTTL)}
+}
+
+// invalidate drops one cached entry, used by write operations so the next
+// describe of the touched resource hits the API.
+func (d *describeCache) invalidate(key string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.entries, key)
+}
+
+// clear drops every cached entry.
+func (d *describeCache) clear() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.entries = nil
+}
+
+// InvalidateDescribeCache drops all cached describe results, used by the
+// refresh paths so they always hit the API.
+func (c *Client) InvalidateDescribeCache() {
+	c.describes.clear()
+}
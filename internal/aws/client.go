@@ -3,23 +3,32 @@ package aws
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
+	"net"
+	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/apigateway"
 	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 )
 
 // Client wraps AWS service clients for a specific profile/region.
@@ -35,8 +44,77 @@ type Client struct {
 	ec2      *ec2.Client
 	ssm      *ssm.Client
 	cwlogs   *cloudwatchlogs.Client
+	cw       *cloudwatch.Client
 	sqs      *sqs.Client
 	dynamodb *dynamodb.Client
+	iam      *iam.Client
+	sts      *sts.Client
+
+	// Permission preflight cache (per client, i.e. per profile/region session)
+	preflightMu    sync.Mutex
+	preflightCache map[string]bool
+	callerARN      string
+
+	// TTL cache for describe-style results (also per profile/region session)
+	describes describeCache
+}
+
+// Endpoint override state, set once at startup from command-line flags.
+// When set, all service clients are constructed against this base endpoint
+// (e.g. a LocalStack or VPC endpoint URL) instead of the public AWS endpoints.
+var (
+	endpointURL string
+	noVerifySSL bool
+)
+
+// SetEndpointOverride configures a custom base endpoint URL for all service
+// clients, optionally disabling TLS certificate verification (LocalStack).
+// Returns an error if the URL is not a valid http(s) URL.
+func SetEndpointOverride(rawURL string, skipVerifySSL bool) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid endpoint URL %q: %w", rawURL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("invalid endpoint URL %q: scheme must be http or https", rawURL)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("invalid endpoint URL %q: missing host", rawURL)
+	}
+	endpointURL = rawURL
+	noVerifySSL = skipVerifySSL
+	return nil
+}
+
+// EndpointOverride returns the configured endpoint URL, or empty if none.
+func EndpointOverride() string {
+	return endpointURL
+}
+
+// CheckEndpoint verifies the configured endpoint is reachable via TCP.
+// Returns nil when no override is configured.
+func CheckEndpoint(timeout time.Duration) error {
+	if endpointURL == "" {
+		return nil
+	}
+	u, err := url.Parse(endpointURL)
+	if err != nil {
+		return err
+	}
+	host := u.Host
+	if u.Port() == "" {
+		if u.Scheme == "https" {
+			host += ":443"
+		} else {
+			host += ":80"
+		}
+	}
+	conn, err := net.DialTimeout("tcp", host, timeout)
+	if err != nil {
+		return fmt.Errorf("endpoint %s is unreachable: %w", endpointURL, err)
+	}
+	conn.Close()
+	return nil
 }
 
 // NewClient creates a new AWS client using the specified profile.
@@ -53,6 +131,16 @@ func NewClient(ctx context.Context, profile, region string) (*Client, error) {
 		opts = append(opts, config.WithRegion(region))
 	}
 
+	// Route all service clients through the endpoint override if one is set
+	if endpointURL != "" {
+		opts = append(opts, config.WithBaseEndpoint(endpointURL))
+		if noVerifySSL {
+			opts = append(opts, config.WithHTTPClient(&http.Client{
+				Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},
+			}))
+		}
+	}
+
 	cfg, err := config.LoadDefaultConfig(ctx, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load AWS config: %w", err)
@@ -74,8 +162,13 @@ func NewClient(ctx context.Context, profile, region string) (*Client, error) {
 		ec2:      ec2.NewFromConfig(cfg),
 		ssm:      ssm.NewFromConfig(cfg),
 		cwlogs:   cloudwatchlogs.NewFromConfig(cfg),
+		cw:       cloudwatch.NewFromConfig(cfg),
 		sqs:      sqs.NewFromConfig(cfg),
 		dynamodb: dynamodb.NewFromConfig(cfg),
+		iam:      iam.NewFromConfig(cfg),
+		sts:      sts.NewFromConfig(cfg),
+
+		preflightCache: make(map[string]bool),
 	}, nil
 }
 
@@ -129,6 +222,11 @@ func (c *Client) CloudWatchLogs() *cloudwatchlogs.Client {
 	return c.cwlogs
 }
 
+// CloudWatch returns the CloudWatch (metrics/alarms) client.
+func (c *Client) CloudWatch() *cloudwatch.Client {
+	return c.cw
+}
+
 // SQS returns the SQS client.
 func (c *Client) SQS() *sqs.Client {
 	return c.sqs
@@ -139,11 +237,35 @@ func (c *Client) DynamoDB() *dynamodb.Client {
 	return c.dynamodb
 }
 
+// IAM returns the IAM client.
+func (c *Client) IAM() *iam.Client {
+	return c.iam
+}
+
+// STS returns the STS client.
+func (c *Client) STS() *sts.Client {
+	return c.sts
+}
+
 // Config returns the underlying AWS config.
 func (c *Client) Config() aws.Config {
 	return c.cfg
 }
 
+// IsSSOTokenExpired reports whether err looks like an expired or missing
+// AWS SSO token, which needs `aws sso login` rather than different config.
+func IsSSOTokenExpired(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "sso session has expired") ||
+		strings.Contains(msg, "token has expired") ||
+		strings.Contains(msg, "ssoproviderinvalidtoken") ||
+		strings.Contains(msg, "invalidgrantexception") ||
+		strings.Contains(msg, "failed to refresh cached credentials")
+}
+
 // ListProfiles returns all available AWS profiles from the config file.
 func ListProfiles() ([]string, error) {
 	homeDir, err := os.UserHomeDir()
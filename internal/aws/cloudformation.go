@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
@@ -105,16 +106,116 @@ func (c *Client) DescribeStack(ctx context.Context, stackName string) (*model.St
 		})
 	}
 
+	// NoEcho isn't on the DescribeStacks output; the template summary
+	// carries the declarations. Best-effort: parameters just show their
+	// (already masked) values when the summary call fails.
+	noEcho := map[string]bool{}
+	if summary, err := c.cfn.GetTemplateSummary(ctx, &cloudformation.GetTemplateSummaryInput{
+		StackName: aws.String(stackName),
+	}); err == nil {
+		for _, d := range summary.Parameters {
+			if aws.ToBool(d.NoEcho) {
+				noEcho[aws.ToString(d.ParameterKey)] = true
+			}
+		}
+	}
+
 	for _, p := range s.Parameters {
 		stack.Parameters = append(stack.Parameters, model.StackParameter{
-			Key:   aws.ToString(p.ParameterKey),
-			Value: aws.ToString(p.ParameterValue),
+			Key:    aws.ToString(p.ParameterKey),
+			Value:  aws.ToString(p.ParameterValue),
+			NoEcho: noEcho[aws.ToString(p.ParameterKey)],
 		})
 	}
 
 	return stack, nil
 }
 
+// GetStackEvents returns up to limit events for a stack, newest first, with
+// real paging - stuck updates can have event histories spanning many pages.
+func (c *Client) GetStackEvents(ctx context.Context, stackName string, limit int) ([]model.StackEvent, error) {
+	log.Debug("Getting events for stack: %s (limit %d)", stackName, limit)
+
+	var events []model.StackEvent
+	paginator := cloudformation.NewDescribeStackEventsPaginator(c.cfn, &cloudformation.DescribeStackEventsInput{
+		StackName: aws.String(stackName),
+	})
+
+	for paginator.HasMorePages() && len(events) < limit {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe stack events: %w", err)
+		}
+
+		for _, e := range page.StackEvents {
+			events = append(events, model.StackEvent{
+				Timestamp: aws.ToTime(e.Timestamp),
+				LogicalID: aws.ToString(e.LogicalResourceId),
+				Type:      aws.ToString(e.ResourceType),
+				Status:    string(e.ResourceStatus),
+				Reason:    aws.ToString(e.ResourceStatusReason),
+			})
+			if len(events) >= limit {
+				break
+			}
+		}
+	}
+	return events, nil
+}
+
+// DetectStackDrift starts a drift detection run for a stack and polls until
+// it completes, returning the resulting drift status (IN_SYNC, DRIFTED, ...).
+func (c *Client) DetectStackDrift(ctx context.Context, stackName string) (string, error) {
+	out, err := c.cfn.DetectStackDrift(ctx, &cloudformation.DetectStackDriftInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to start drift detection: %w", err)
+	}
+
+	for {
+		status, err := c.cfn.DescribeStackDriftDetectionStatus(ctx, &cloudformation.DescribeStackDriftDetectionStatusInput{
+			StackDriftDetectionId: out.StackDriftDetectionId,
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to check drift detection status: %w", err)
+		}
+
+		switch status.DetectionStatus {
+		case cftypes.StackDriftDetectionStatusDetectionComplete:
+			return string(status.StackDriftStatus), nil
+		case cftypes.StackDriftDetectionStatusDetectionFailed:
+			return "", fmt.Errorf("drift detection failed: %s", aws.ToString(status.DetectionStatusReason))
+		}
+
+		select {
+		case <-time.After(2 * time.Second):
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+}
+
+// ListStackResources returns every resource in a stack as model types,
+// generalizing GetStackResources (which callers use with a type filter).
+func (c *Client) ListStackResources(ctx context.Context, stackName string) ([]model.StackResource, error) {
+	summaries, err := c.GetStackResources(ctx, stackName, "")
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]model.StackResource, 0, len(summaries))
+	for _, r := range summaries {
+		resources = append(resources, model.StackResource{
+			LogicalID:  aws.ToString(r.LogicalResourceId),
+			PhysicalID: aws.ToString(r.PhysicalResourceId),
+			Type:       aws.ToString(r.ResourceType),
+			Status:     string(r.ResourceStatus),
+		})
+	}
+	return resources, nil
+}
+
 // GetStackResources returns resources for a stack, optionally filtered by type.
 func (c *Client) GetStackResources(ctx context.Context, stackName string, resourceType string) ([]cftypes.StackResourceSummary, error) {
 	log.Debug("Getting resources for stack: %s (type filter: %s)", stackName, resourceType)
@@ -140,6 +241,35 @@ func (c *Client) GetStackResources(ctx context.Context, stackName string, resour
 	return resources, nil
 }
 
+// StackResourceCounts summarizes the resource types vaws can drill into.
+type StackResourceCounts struct {
+	Functions int
+	Queues    int
+	Tables    int
+}
+
+// CountStackResources tallies the Lambda/SQS/DynamoDB resources in a stack
+// with a single ListStackResources pass.
+func (c *Client) CountStackResources(ctx context.Context, stackName string) (StackResourceCounts, error) {
+	resources, err := c.GetStackResources(ctx, stackName, "")
+	if err != nil {
+		return StackResourceCounts{}, err
+	}
+
+	var counts StackResourceCounts
+	for _, r := range resources {
+		switch aws.ToString(r.ResourceType) {
+		case "AWS::Lambda::Function":
+			counts.Functions++
+		case "AWS::SQS::Queue":
+			counts.Queues++
+		case "AWS::DynamoDB::Table":
+			counts.Tables++
+		}
+	}
+	return counts, nil
+}
+
 // GetECSServicesFromStack returns ECS service ARNs/names defined in a CloudFormation stack.
 func (c *Client) GetECSServicesFromStack(ctx context.Context, stackName string) ([]string, error) {
 	resources, err := c.GetStackResources(ctx, stackName, "AWS::ECS::Service")
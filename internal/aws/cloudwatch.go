@@ -4,10 +4,12 @@ package aws
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	cwltypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
 
 	"vaws/internal/log"
 	"vaws/internal/model"
@@ -17,13 +19,23 @@ import (
 // startTime is milliseconds since epoch for incremental fetching.
 // Returns log entries, the next startTime to use, and any error.
 func (c *Client) FetchLogs(ctx context.Context, logGroup, logStream string, startTime int64, limit int32) ([]model.CloudWatchLogEntry, int64, error) {
-	log.Debug("Fetching CloudWatch logs: group=%s, stream=%s, startTime=%d", logGroup, logStream, startTime)
+	return c.FetchLogsFiltered(ctx, logGroup, logStream, "", startTime, limit)
+}
+
+// FetchLogsFiltered is FetchLogs with a CloudWatch filterPattern applied
+// server-side, so high-volume streams are filtered across all data rather
+// than within a single fetch page. Incremental startTime behavior is kept.
+func (c *Client) FetchLogsFiltered(ctx context.Context, logGroup, logStream, filterPattern string, startTime int64, limit int32) ([]model.CloudWatchLogEntry, int64, error) {
+	log.Debug("Fetching CloudWatch logs: group=%s, stream=%s, pattern=%q, startTime=%d", logGroup, logStream, filterPattern, startTime)
 
 	input := &cloudwatchlogs.FilterLogEventsInput{
 		LogGroupName:   aws.String(logGroup),
 		LogStreamNames: []string{logStream},
 		Limit:          aws.Int32(limit),
 	}
+	if filterPattern != "" {
+		input.FilterPattern = aws.String(filterPattern)
+	}
 
 	if startTime > 0 {
 		input.StartTime = aws.Int64(startTime)
@@ -68,6 +80,63 @@ func (c *Client) FetchLogs(ctx context.Context, logGroup, logStream string, star
 	return entries, nextStartTime, nil
 }
 
+// RunLogsInsightsQuery runs a Logs Insights query over a log group and
+// blocks until it completes, returning column/value rows. When ctx is
+// cancelled the query is stopped server-side so nothing keeps running.
+func (c *Client) RunLogsInsightsQuery(ctx context.Context, logGroup, query string, start, end time.Time) ([]model.InsightsRow, error) {
+	log.Debug("Starting Insights query on %s", logGroup)
+
+	startOut, err := c.cwlogs.StartQuery(ctx, &cloudwatchlogs.StartQueryInput{
+		LogGroupName: aws.String(logGroup),
+		QueryString:  aws.String(query),
+		StartTime:    aws.Int64(start.Unix()),
+		EndTime:      aws.Int64(end.Unix()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start Insights query: %w", err)
+	}
+	queryID := startOut.QueryId
+
+	for {
+		select {
+		case <-ctx.Done():
+			// Don't leave the query running server-side
+			stopCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			_, _ = c.cwlogs.StopQuery(stopCtx, &cloudwatchlogs.StopQueryInput{QueryId: queryID})
+			cancel()
+			return nil, ctx.Err()
+		case <-time.After(time.Second):
+		}
+
+		out, err := c.cwlogs.GetQueryResults(ctx, &cloudwatchlogs.GetQueryResultsInput{
+			QueryId: queryID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get query results: %w", err)
+		}
+
+		switch out.Status {
+		case cwltypes.QueryStatusComplete:
+			var rows []model.InsightsRow
+			for _, result := range out.Results {
+				var row model.InsightsRow
+				for _, f := range result {
+					field := aws.ToString(f.Field)
+					if field == "@ptr" {
+						continue // internal record pointer, never useful to show
+					}
+					row = append(row, model.InsightsField{Field: field, Value: aws.ToString(f.Value)})
+				}
+				rows = append(rows, row)
+			}
+			log.Info("Insights query returned %d rows", len(rows))
+			return rows, nil
+		case cwltypes.QueryStatusFailed, cwltypes.QueryStatusCancelled, cwltypes.QueryStatusTimeout:
+			return nil, fmt.Errorf("insights query %s", strings.ToLower(string(out.Status)))
+		}
+	}
+}
+
 // BuildLogStreamName constructs the log stream name from components.
 // Format: {prefix}/{container-name}/{task-id}
 func BuildLogStreamName(prefix, containerName, taskID string) string {
@@ -79,12 +148,21 @@ func BuildLogStreamName(prefix, containerName, taskID string) string {
 // which is ideal for Lambda functions where each invocation creates a new stream.
 // startTime is milliseconds since epoch for incremental fetching.
 func (c *Client) FetchLambdaLogs(ctx context.Context, logGroup string, startTime int64, limit int32) ([]model.CloudWatchLogEntry, int64, error) {
-	log.Debug("Fetching Lambda CloudWatch logs: group=%s, startTime=%d", logGroup, startTime)
+	return c.FetchLambdaLogsFiltered(ctx, logGroup, "", startTime, limit)
+}
+
+// FetchLambdaLogsFiltered is FetchLambdaLogs with a server-side
+// filterPattern applied across all streams of the log group.
+func (c *Client) FetchLambdaLogsFiltered(ctx context.Context, logGroup, filterPattern string, startTime int64, limit int32) ([]model.CloudWatchLogEntry, int64, error) {
+	log.Debug("Fetching Lambda CloudWatch logs: group=%s, pattern=%q, startTime=%d", logGroup, filterPattern, startTime)
 
 	input := &cloudwatchlogs.FilterLogEventsInput{
 		LogGroupName: aws.String(logGroup),
 		Limit:        aws.Int32(limit),
 	}
+	if filterPattern != "" {
+		input.FilterPattern = aws.String(filterPattern)
+	}
 
 	if startTime > 0 {
 		input.StartTime = aws.Int64(startTime)
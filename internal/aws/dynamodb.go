@@ -2,9 +2,12 @@ package aws
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"sort"
+	"strings"
 	"sync"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -172,13 +175,17 @@ func convertTable(t *dbtypes.TableDescription) *model.Table {
 				KeyType:       string(k.KeyType),
 			})
 		}
-		table.GlobalSecondaryIndexes = append(table.GlobalSecondaryIndexes, model.GlobalSecondaryIndex{
-			IndexName:  aws.ToString(gsi.IndexName),
-			KeySchema:  keySchema,
-			Status:     string(gsi.IndexStatus),
-			ItemCount:  derefInt64(gsi.ItemCount),
-			SizeBytes:  derefInt64(gsi.IndexSizeBytes),
-		})
+		index := model.GlobalSecondaryIndex{
+			IndexName: aws.ToString(gsi.IndexName),
+			KeySchema: keySchema,
+			Status:    string(gsi.IndexStatus),
+			ItemCount: derefInt64(gsi.ItemCount),
+			SizeBytes: derefInt64(gsi.IndexSizeBytes),
+		}
+		if gsi.Projection != nil {
+			index.Projection = string(gsi.Projection.ProjectionType)
+		}
+		table.GlobalSecondaryIndexes = append(table.GlobalSecondaryIndexes, index)
 	}
 
 	// Local Secondary Indexes
@@ -190,12 +197,16 @@ func convertTable(t *dbtypes.TableDescription) *model.Table {
 				KeyType:       string(k.KeyType),
 			})
 		}
-		table.LocalSecondaryIndexes = append(table.LocalSecondaryIndexes, model.LocalSecondaryIndex{
-			IndexName:  aws.ToString(lsi.IndexName),
-			KeySchema:  keySchema,
-			ItemCount:  derefInt64(lsi.ItemCount),
-			SizeBytes:  derefInt64(lsi.IndexSizeBytes),
-		})
+		index := model.LocalSecondaryIndex{
+			IndexName: aws.ToString(lsi.IndexName),
+			KeySchema: keySchema,
+			ItemCount: derefInt64(lsi.ItemCount),
+			SizeBytes: derefInt64(lsi.IndexSizeBytes),
+		}
+		if lsi.Projection != nil {
+			index.Projection = string(lsi.Projection.ProjectionType)
+		}
+		table.LocalSecondaryIndexes = append(table.LocalSecondaryIndexes, index)
 	}
 
 	// Streams
@@ -220,6 +231,37 @@ func derefInt64(p *int64) int64 {
 	return *p
 }
 
+// ExactItemCount counts a table's items with a paged Select COUNT scan.
+// Unlike the ~6-hourly DescribeTable estimate this is precise, but it reads
+// the whole table and consumes capacity accordingly.
+func (c *Client) ExactItemCount(ctx context.Context, tableName string) (int64, error) {
+	log.Debug("Counting items in table %s...", tableName)
+
+	var total int64
+	var lastKey map[string]dbtypes.AttributeValue
+	for {
+		input := &dynamodb.ScanInput{
+			TableName: aws.String(tableName),
+			Select:    dbtypes.SelectCount,
+		}
+		if lastKey != nil {
+			input.ExclusiveStartKey = lastKey
+		}
+
+		out, err := c.dynamodb.Scan(ctx, input)
+		if err != nil {
+			return 0, fmt.Errorf("failed to count items: %w", err)
+		}
+		total += int64(out.Count)
+
+		if out.LastEvaluatedKey == nil {
+			break
+		}
+		lastKey = out.LastEvaluatedKey
+	}
+	return total, nil
+}
+
 // QueryTable executes a query on a DynamoDB table.
 func (c *Client) QueryTable(ctx context.Context, params model.QueryParams, lastKey map[string]interface{}) (*model.QueryResult, error) {
 	log.Debug("Querying table %s with PK=%s", params.TableName, params.PartitionKeyVal)
@@ -348,6 +390,211 @@ func (c *Client) ScanTable(ctx context.Context, params model.ScanParams, lastKey
 	return convertScanOutput(output, params.PartitionKeyName, params.SortKeyName), nil
 }
 
+// UpdateItem applies a SET update for the given attributes to one item.
+// Key attributes cannot appear in updates; DynamoDB rejects them.
+func (c *Client) UpdateItem(ctx context.Context, tableName string, key map[string]dbtypes.AttributeValue, updates map[string]dbtypes.AttributeValue) error {
+	if len(updates) == 0 {
+		return nil
+	}
+
+	names := make(map[string]string, len(updates))
+	values := make(map[string]dbtypes.AttributeValue, len(updates))
+	sets := make([]string, 0, len(updates))
+	i := 0
+	for attr, value := range updates {
+		nameKey := fmt.Sprintf("#a%d", i)
+		valueKey := fmt.Sprintf(":v%d", i)
+		names[nameKey] = attr
+		values[valueKey] = value
+		sets = append(sets, nameKey+" = "+valueKey)
+		i++
+	}
+
+	_, err := c.dynamodb.UpdateItem(ctx, &dynamodb.UpdateItemInput{
+		TableName:                 aws.String(tableName),
+		Key:                       key,
+		UpdateExpression:          aws.String("SET " + strings.Join(sets, ", ")),
+		ExpressionAttributeNames:  names,
+		ExpressionAttributeValues: values,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update item: %w", err)
+	}
+	return nil
+}
+
+// ErrItemExists reports a conditional put rejected because an item with the
+// same key already exists.
+var ErrItemExists = errors.New("an item with this key already exists")
+
+// PutItem writes an item unconditionally.
+func (c *Client) PutItem(ctx context.Context, tableName string, item map[string]dbtypes.AttributeValue) error {
+	return c.putItem(ctx, tableName, item, "")
+}
+
+// PutItemIfNotExists writes an item guarded by
+// attribute_not_exists(guardAttr); an existing item surfaces as ErrItemExists.
+func (c *Client) PutItemIfNotExists(ctx context.Context, tableName string, item map[string]dbtypes.AttributeValue, guardAttr string) error {
+	return c.putItem(ctx, tableName, item, guardAttr)
+}
+
+func (c *Client) putItem(ctx context.Context, tableName string, item map[string]dbtypes.AttributeValue, guardAttr string) error {
+	log.Debug("Putting item into %s", tableName)
+
+	input := &dynamodb.PutItemInput{
+		TableName: aws.String(tableName),
+		Item:      item,
+	}
+	if guardAttr != "" {
+		input.ConditionExpression = aws.String("attribute_not_exists(#g)")
+		input.ExpressionAttributeNames = map[string]string{"#g": guardAttr}
+	}
+
+	_, err := c.dynamodb.PutItem(ctx, input)
+	if err != nil {
+		var conditionFailed *dbtypes.ConditionalCheckFailedException
+		if errors.As(err, &conditionFailed) {
+			return ErrItemExists
+		}
+		return fmt.Errorf("failed to put item: %w", err)
+	}
+	return nil
+}
+
+// DeleteItem removes one item by its full key.
+func (c *Client) DeleteItem(ctx context.Context, tableName string, key map[string]dbtypes.AttributeValue) error {
+	log.Debug("Deleting item from %s", tableName)
+
+	_, err := c.dynamodb.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName: aws.String(tableName),
+		Key:       key,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete item: %w", err)
+	}
+	return nil
+}
+
+// UnmarshalAttributeValueJSON parses AttributeValue wire-format JSON
+// ({"attr":{"S":"value"}}) back into SDK attribute values, the inverse of
+// the marshaling used for put-item export.
+func UnmarshalAttributeValueJSON(data string) (map[string]dbtypes.AttributeValue, error) {
+	var wire map[string]interface{}
+	if err := json.Unmarshal([]byte(data), &wire); err != nil {
+		return nil, fmt.Errorf("invalid attribute JSON: %w", err)
+	}
+
+	out := make(map[string]dbtypes.AttributeValue, len(wire))
+	for attr, v := range wire {
+		av, err := wireToAttributeValue(v)
+		if err != nil {
+			return nil, fmt.Errorf("attribute %s: %w", attr, err)
+		}
+		out[attr] = av
+	}
+	return out, nil
+}
+
+// wireToAttributeValue converts one wire-format value to an AttributeValue.
+func wireToAttributeValue(v interface{}) (dbtypes.AttributeValue, error) {
+	wrapper, ok := v.(map[string]interface{})
+	if !ok || len(wrapper) != 1 {
+		return nil, fmt.Errorf("expected a single-key type wrapper, got %v", v)
+	}
+
+	for kind, raw := range wrapper {
+		switch kind {
+		case "S":
+			s, _ := raw.(string)
+			return &dbtypes.AttributeValueMemberS{Value: s}, nil
+		case "N":
+			s, _ := raw.(string)
+			return &dbtypes.AttributeValueMemberN{Value: s}, nil
+		case "BOOL":
+			b, _ := raw.(bool)
+			return &dbtypes.AttributeValueMemberBOOL{Value: b}, nil
+		case "NULL":
+			return &dbtypes.AttributeValueMemberNULL{Value: true}, nil
+		case "B":
+			s, _ := raw.(string)
+			data, err := base64.StdEncoding.DecodeString(s)
+			if err != nil {
+				return nil, fmt.Errorf("invalid base64 binary: %w", err)
+			}
+			return &dbtypes.AttributeValueMemberB{Value: data}, nil
+		case "SS", "NS":
+			arr, _ := raw.([]interface{})
+			set := make([]string, 0, len(arr))
+			for _, item := range arr {
+				s, _ := item.(string)
+				set = append(set, s)
+			}
+			if kind == "SS" {
+				return &dbtypes.AttributeValueMemberSS{Value: set}, nil
+			}
+			return &dbtypes.AttributeValueMemberNS{Value: set}, nil
+		case "L":
+			arr, _ := raw.([]interface{})
+			list := make([]dbtypes.AttributeValue, 0, len(arr))
+			for _, item := range arr {
+				av, err := wireToAttributeValue(item)
+				if err != nil {
+					return nil, err
+				}
+				list = append(list, av)
+			}
+			return &dbtypes.AttributeValueMemberL{Value: list}, nil
+		case "M":
+			mm, _ := raw.(map[string]interface{})
+			inner := make(map[string]dbtypes.AttributeValue, len(mm))
+			for k, item := range mm {
+				av, err := wireToAttributeValue(item)
+				if err != nil {
+					return nil, err
+				}
+				inner[k] = av
+			}
+			return &dbtypes.AttributeValueMemberM{Value: inner}, nil
+		default:
+			return nil, fmt.Errorf("unsupported attribute type %q", kind)
+		}
+	}
+	return nil, fmt.Errorf("empty attribute value")
+}
+
+// ExecutePartiQL runs a PartiQL statement, reusing the QueryResult paging
+// structure. nextToken continues a previous page.
+func (c *Client) ExecutePartiQL(ctx context.Context, statement string, nextToken *string) (*model.QueryResult, error) {
+	log.Debug("Executing PartiQL statement")
+
+	input := &dynamodb.ExecuteStatementInput{
+		Statement:              aws.String(statement),
+		ReturnConsumedCapacity: dbtypes.ReturnConsumedCapacityTotal,
+	}
+	if nextToken != nil {
+		input.NextToken = nextToken
+	}
+
+	out, err := c.dynamodb.ExecuteStatement(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("PartiQL statement failed: %w", err)
+	}
+
+	result := &model.QueryResult{
+		Count:        len(out.Items),
+		ScannedCount: len(out.Items),
+		HasMorePages: out.NextToken != nil,
+		NextToken:    aws.ToString(out.NextToken),
+	}
+	if out.ConsumedCapacity != nil && out.ConsumedCapacity.CapacityUnits != nil {
+		result.ConsumedCapacity = *out.ConsumedCapacity.CapacityUnits
+	}
+	for _, item := range out.Items {
+		result.Items = append(result.Items, convertItem(item, "", ""))
+	}
+	return result, nil
+}
+
 // convertQueryOutput converts DynamoDB query output to our model.
 func convertQueryOutput(output *dynamodb.QueryOutput, pkName, skName string) *model.QueryResult {
 	result := &model.QueryResult{
@@ -407,6 +654,12 @@ func convertItem(item map[string]dbtypes.AttributeValue, pkName, skName string)
 		JSON: jsonStr,
 	}
 
+	// Preserve the exact AttributeValue shapes for put-item reproduction;
+	// Raw loses the S/N distinction since numbers are kept as strings
+	if wire, err := marshalAttributeValueJSON(item); err == nil {
+		ddbItem.AttributeJSON = wire
+	}
+
 	// Extract PK/SK values for display
 	if pkName != "" {
 		if val, ok := raw[pkName]; ok {
@@ -422,6 +675,70 @@ func convertItem(item map[string]dbtypes.AttributeValue, pkName, skName string)
 	return ddbItem
 }
 
+// marshalAttributeValueJSON renders an item in the DynamoDB AttributeValue
+// wire format ({"attr":{"S":"value"}}) used by the CLI and SDKs.
+func marshalAttributeValueJSON(item map[string]dbtypes.AttributeValue) (string, error) {
+	wire := make(map[string]interface{}, len(item))
+	for k, v := range item {
+		wire[k] = attributeValueToWire(v)
+	}
+	data, err := json.Marshal(wire)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// attributeValueToWire converts a single AttributeValue to its JSON wire shape.
+func attributeValueToWire(av dbtypes.AttributeValue) interface{} {
+	switch v := av.(type) {
+	case *dbtypes.AttributeValueMemberS:
+		return map[string]interface{}{"S": v.Value}
+	case *dbtypes.AttributeValueMemberN:
+		return map[string]interface{}{"N": v.Value}
+	case *dbtypes.AttributeValueMemberB:
+		return map[string]interface{}{"B": v.Value}
+	case *dbtypes.AttributeValueMemberBOOL:
+		return map[string]interface{}{"BOOL": v.Value}
+	case *dbtypes.AttributeValueMemberNULL:
+		return map[string]interface{}{"NULL": true}
+	case *dbtypes.AttributeValueMemberSS:
+		return map[string]interface{}{"SS": v.Value}
+	case *dbtypes.AttributeValueMemberNS:
+		return map[string]interface{}{"NS": v.Value}
+	case *dbtypes.AttributeValueMemberBS:
+		return map[string]interface{}{"BS": v.Value}
+	case *dbtypes.AttributeValueMemberL:
+		list := make([]interface{}, len(v.Value))
+		for i, item := range v.Value {
+			list[i] = attributeValueToWire(item)
+		}
+		return map[string]interface{}{"L": list}
+	case *dbtypes.AttributeValueMemberM:
+		mp := make(map[string]interface{}, len(v.Value))
+		for k, item := range v.Value {
+			mp[k] = attributeValueToWire(item)
+		}
+		return map[string]interface{}{"M": mp}
+	default:
+		return map[string]interface{}{"S": fmt.Sprintf("%v", av)}
+	}
+}
+
+// PutItemCommand builds an aws CLI put-item command that recreates an item,
+// shell-quoting the AttributeValue JSON.
+func (c *Client) PutItemCommand(tableName, attributeJSON string) string {
+	cmd := fmt.Sprintf("aws dynamodb put-item --table-name %s --item '%s'",
+		tableName, strings.ReplaceAll(attributeJSON, "'", `'\''`))
+	if c.region != "" {
+		cmd += " --region " + c.region
+	}
+	if c.profile != "" {
+		cmd += " --profile " + c.profile
+	}
+	return cmd
+}
+
 // convertFromAttributeValueMap converts DynamoDB attribute values to Go native types.
 func convertFromAttributeValueMap(attrs map[string]dbtypes.AttributeValue) map[string]interface{} {
 	result := make(map[string]interface{})
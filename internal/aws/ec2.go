@@ -288,3 +288,52 @@ func convertEC2Instance(inst types.Instance) model.EC2Instance {
 
 	return instance
 }
+
+// RebootInstance reboots an EC2 instance.
+func (c *Client) RebootInstance(ctx context.Context, instanceID string) error {
+	_, err := c.ec2.RebootInstances(ctx, &ec2.RebootInstancesInput{
+		InstanceIds: []string{instanceID},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reboot %s: %w", instanceID, err)
+	}
+	return nil
+}
+
+// StopInstance stops an EC2 instance.
+func (c *Client) StopInstance(ctx context.Context, instanceID string) error {
+	_, err := c.ec2.StopInstances(ctx, &ec2.StopInstancesInput{
+		InstanceIds: []string{instanceID},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to stop %s: %w", instanceID, err)
+	}
+	return nil
+}
+
+// StartInstance starts a stopped EC2 instance.
+func (c *Client) StartInstance(ctx context.Context, instanceID string) error {
+	_, err := c.ec2.StartInstances(ctx, &ec2.StartInstancesInput{
+		InstanceIds: []string{instanceID},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to start %s: %w", instanceID, err)
+	}
+	return nil
+}
+
+// GetInstanceState returns an instance's current lifecycle state name
+// (pending, running, stopping, stopped, ...), including stopped instances.
+func (c *Client) GetInstanceState(ctx context.Context, instanceID string) (string, error) {
+	out, err := c.ec2.DescribeInstanceStatus(ctx, &ec2.DescribeInstanceStatusInput{
+		InstanceIds:         []string{instanceID},
+		IncludeAllInstances: aws.Bool(true),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to describe status of %s: %w", instanceID, err)
+	}
+	if len(out.InstanceStatuses) == 0 {
+		return "", fmt.Errorf("no status returned for %s", instanceID)
+	}
+	return string(out.InstanceStatuses[0].InstanceState.Name), nil
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecs"
 	ecstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
 
 	"vaws/internal/log"
 	"vaws/internal/model"
@@ -219,10 +221,23 @@ func convertService(svc ecstypes.Service) model.Service {
 		}
 	}
 
+	// Keep only the most recent events; ECS returns up to 100, newest first
+	for i, e := range svc.Events {
+		if i >= 20 {
+			break
+		}
+		service.Events = append(service.Events, model.ServiceEvent{
+			ID:        aws.ToString(e.Id),
+			Message:   aws.ToString(e.Message),
+			CreatedAt: aws.ToTime(e.CreatedAt),
+		})
+	}
+
 	for _, d := range svc.Deployments {
 		service.Deployments = append(service.Deployments, model.Deployment{
 			ID:             aws.ToString(d.Id),
 			Status:         aws.ToString(d.Status),
+			RolloutState:   string(d.RolloutState),
 			DesiredCount:   int(d.DesiredCount),
 			RunningCount:   int(d.RunningCount),
 			PendingCount:   int(d.PendingCount),
@@ -235,6 +250,50 @@ func convertService(svc ecstypes.Service) model.Service {
 	return service
 }
 
+// GetServiceEvents returns a service's most recent event log entries,
+// newest first as ECS returns them.
+func (c *Client) GetServiceEvents(ctx context.Context, clusterARN, serviceName string, limit int) ([]model.ServiceEvent, error) {
+	out, err := c.ecs.DescribeServices(ctx, &ecs.DescribeServicesInput{
+		Cluster:  aws.String(clusterARN),
+		Services: []string{serviceName},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe service %s: %w", serviceName, err)
+	}
+	if len(out.Services) == 0 {
+		return nil, fmt.Errorf("service %s not found", serviceName)
+	}
+
+	events := make([]model.ServiceEvent, 0, limit)
+	for i, e := range out.Services[0].Events {
+		if i >= limit {
+			break
+		}
+		events = append(events, model.ServiceEvent{
+			ID:        aws.ToString(e.Id),
+			Message:   aws.ToString(e.Message),
+			CreatedAt: aws.ToTime(e.CreatedAt),
+		})
+	}
+	return events, nil
+}
+
+// ForceNewDeployment redeploys a service with its current task definition,
+// the standard way to roll tasks after pushing the same image tag.
+func (c *Client) ForceNewDeployment(ctx context.Context, clusterARN, serviceName string) error {
+	log.Info("Forcing new deployment for service %s", serviceName)
+
+	_, err := c.ecs.UpdateService(ctx, &ecs.UpdateServiceInput{
+		Cluster:            aws.String(clusterARN),
+		Service:            aws.String(serviceName),
+		ForceNewDeployment: true,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to force new deployment for %s: %w", serviceName, err)
+	}
+	return nil
+}
+
 // ListTasksForService returns running tasks for a service.
 func (c *Client) ListTasksForService(ctx context.Context, clusterARN, serviceName string) ([]model.Task, error) {
 	log.Debug("Listing tasks for service: %s in cluster %s", serviceName, clusterARN)
@@ -267,13 +326,17 @@ func (c *Client) ListTasksForService(ctx context.Context, clusterARN, serviceNam
 	var tasks []model.Task
 	for _, t := range descOut.Tasks {
 		task := model.Task{
-			TaskARN:           aws.ToString(t.TaskArn),
-			ClusterARN:        aws.ToString(t.ClusterArn),
-			TaskDefinitionARN: aws.ToString(t.TaskDefinitionArn),
-			LastStatus:        aws.ToString(t.LastStatus),
-			DesiredStatus:     aws.ToString(t.DesiredStatus),
-			LaunchType:        string(t.LaunchType),
-			StartedAt:         aws.ToTime(t.StartedAt),
+			TaskARN:              aws.ToString(t.TaskArn),
+			ClusterARN:           aws.ToString(t.ClusterArn),
+			TaskDefinitionARN:    aws.ToString(t.TaskDefinitionArn),
+			ContainerInstanceARN: aws.ToString(t.ContainerInstanceArn),
+			PlatformVersion:      aws.ToString(t.PlatformVersion),
+			LastStatus:           aws.ToString(t.LastStatus),
+			DesiredStatus:        aws.ToString(t.DesiredStatus),
+			HealthStatus:         string(t.HealthStatus),
+			AvailabilityZone:     aws.ToString(t.AvailabilityZone),
+			LaunchType:           string(t.LaunchType),
+			StartedAt:            aws.ToTime(t.StartedAt),
 		}
 
 		// Extract task ID from ARN
@@ -338,6 +401,51 @@ func (c *Client) ListTasksForService(ctx context.Context, clusterARN, serviceNam
 	return tasks, nil
 }
 
+// DescribeTaskDefinition returns a task definition's container images,
+// sizing, port mappings, and environment for pre-deploy inspection.
+func (c *Client) DescribeTaskDefinition(ctx context.Context, taskDefARN string) (*model.TaskDefinition, error) {
+	out, err := c.ecs.DescribeTaskDefinition(ctx, &ecs.DescribeTaskDefinitionInput{
+		TaskDefinition: aws.String(taskDefARN),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe task definition %s: %w", taskDefARN, err)
+	}
+	td := out.TaskDefinition
+	if td == nil {
+		return nil, fmt.Errorf("task definition %s not found", taskDefARN)
+	}
+
+	def := &model.TaskDefinition{
+		ARN:      aws.ToString(td.TaskDefinitionArn),
+		Family:   aws.ToString(td.Family),
+		Revision: fmt.Sprintf("%d", td.Revision),
+		CPU:      aws.ToString(td.Cpu),
+		Memory:   aws.ToString(td.Memory),
+	}
+
+	for _, cd := range td.ContainerDefinitions {
+		container := model.TaskDefContainer{
+			Name:   aws.ToString(cd.Name),
+			Image:  aws.ToString(cd.Image),
+			CPU:    int(cd.Cpu),
+			Memory: int(aws.ToInt32(cd.Memory)),
+		}
+		for _, pm := range cd.PortMappings {
+			if port := int(aws.ToInt32(pm.ContainerPort)); port > 0 {
+				container.Ports = append(container.Ports, port)
+			}
+		}
+		if len(cd.Environment) > 0 {
+			container.Environment = make(map[string]string, len(cd.Environment))
+			for _, kv := range cd.Environment {
+				container.Environment[aws.ToString(kv.Name)] = aws.ToString(kv.Value)
+			}
+		}
+		def.Containers = append(def.Containers, container)
+	}
+	return def, nil
+}
+
 // getContainerDefinitions fetches container definitions from a task definition.
 func (c *Client) getContainerDefinitions(ctx context.Context, taskDefARN string) []ecstypes.ContainerDefinition {
 	out, err := c.ecs.DescribeTaskDefinition(ctx, &ecs.DescribeTaskDefinitionInput{
@@ -430,6 +538,39 @@ func (c *Client) GetContainerLogConfigs(ctx context.Context, taskDefARN, taskID
 	return configs, nil
 }
 
+// CheckContainerInstanceSSM resolves the EC2 instance behind an ECS
+// container instance and reports whether SSM currently manages it. An
+// unmanaged instance means SSM port forwarding to tasks on it will fail.
+func (c *Client) CheckContainerInstanceSSM(ctx context.Context, clusterARN, containerInstanceARN string) (instanceID string, managed bool, err error) {
+	out, err := c.ecs.DescribeContainerInstances(ctx, &ecs.DescribeContainerInstancesInput{
+		Cluster:            aws.String(clusterARN),
+		ContainerInstances: []string{containerInstanceARN},
+	})
+	if err != nil {
+		return "", false, fmt.Errorf("failed to describe container instance: %w", err)
+	}
+	if len(out.ContainerInstances) == 0 {
+		return "", false, fmt.Errorf("container instance %s not found", containerInstanceARN)
+	}
+	instanceID = aws.ToString(out.ContainerInstances[0].Ec2InstanceId)
+
+	info, err := c.ssm.DescribeInstanceInformation(ctx, &ssm.DescribeInstanceInformationInput{
+		Filters: []ssmtypes.InstanceInformationStringFilter{{
+			Key:    aws.String("InstanceIds"),
+			Values: []string{instanceID},
+		}},
+	})
+	if err != nil {
+		return instanceID, false, fmt.Errorf("failed to check SSM status: %w", err)
+	}
+	for _, i := range info.InstanceInformationList {
+		if aws.ToString(i.InstanceId) == instanceID && i.PingStatus == "Online" {
+			return instanceID, true, nil
+		}
+	}
+	return instanceID, false, nil
+}
+
 // GetSSMTarget returns the SSM target string for port forwarding to a Fargate task.
 // Format: ecs:<cluster-name>_<task-id>_<runtime-id>
 func GetSSMTarget(clusterName, taskID, runtimeID string) string {
@@ -0,0 +1,105 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	"vaws/internal/log"
+)
+
+// SimulateAction checks whether the current principal may perform action on
+// resourceARN using iam:SimulatePrincipalPolicy. The second return value is
+// false when simulation itself was not possible (e.g. the principal lacks
+// iam:SimulatePrincipalPolicy); callers should then just attempt the action.
+// Results are cached per action/resource for the lifetime of the client.
+func (c *Client) SimulateAction(ctx context.Context, action, resourceARN string) (allowed, ok bool) {
+	cacheKey := action + "|" + resourceARN
+
+	c.preflightMu.Lock()
+	if result, hit := c.preflightCache[cacheKey]; hit {
+		c.preflightMu.Unlock()
+		return result, true
+	}
+	c.preflightMu.Unlock()
+
+	sourceARN, err := c.callerPolicySourceARN(ctx)
+	if err != nil {
+		log.Debug("Permission preflight unavailable: %v", err)
+		return false, false
+	}
+
+	input := &iam.SimulatePrincipalPolicyInput{
+		PolicySourceArn: aws.String(sourceARN),
+		ActionNames:     []string{action},
+	}
+	if resourceARN != "" {
+		input.ResourceArns = []string{resourceARN}
+	}
+
+	out, err := c.iam.SimulatePrincipalPolicy(ctx, input)
+	if err != nil {
+		log.Debug("Permission preflight failed for %s: %v", action, err)
+		return false, false
+	}
+	if len(out.EvaluationResults) == 0 {
+		return false, false
+	}
+
+	allowed = out.EvaluationResults[0].EvalDecision == iamtypes.PolicyEvaluationDecisionTypeAllowed
+
+	c.preflightMu.Lock()
+	c.preflightCache[cacheKey] = allowed
+	c.preflightMu.Unlock()
+
+	return allowed, true
+}
+
+// AccountID returns the account id of the current credentials.
+func (c *Client) AccountID(ctx context.Context) (string, error) {
+	out, err := c.sts.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve caller identity: %w", err)
+	}
+	return aws.ToString(out.Account), nil
+}
+
+// callerPolicySourceARN resolves the IAM principal ARN usable as a policy
+// simulation source, cached after the first call. Assumed-role session ARNs
+// are rewritten to the underlying role ARN, which is what
+// SimulatePrincipalPolicy expects.
+func (c *Client) callerPolicySourceARN(ctx context.Context) (string, error) {
+	c.preflightMu.Lock()
+	cached := c.callerARN
+	c.preflightMu.Unlock()
+	if cached != "" {
+		return cached, nil
+	}
+
+	out, err := c.sts.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve caller identity: %w", err)
+	}
+
+	arn := aws.ToString(out.Arn)
+
+	// arn:aws:sts::<acct>:assumed-role/<role>/<session> -> arn:aws:iam::<acct>:role/<role>
+	if strings.Contains(arn, ":assumed-role/") {
+		parts := strings.SplitN(arn, ":assumed-role/", 2)
+		roleParts := strings.SplitN(parts[1], "/", 2)
+		acctParts := strings.Split(parts[0], ":")
+		account := acctParts[len(acctParts)-1]
+		arn = fmt.Sprintf("arn:aws:iam::%s:role/%s", account, roleParts[0])
+	}
+
+	c.preflightMu.Lock()
+	c.callerARN = arn
+	c.preflightMu.Unlock()
+
+	return arn, nil
+}
@@ -2,7 +2,9 @@ package aws
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -59,7 +61,27 @@ func (c *Client) ListFunctionsPagedCallback(ctx context.Context, callback func(f
 }
 
 // DescribeFunction returns detailed information about a Lambda function.
+// fnDescribeCacheKey is the describe-cache key for a function's config.
+func fnDescribeCacheKey(name string) string {
+	return "lambda:describe:" + name
+}
+
+// invalidateFunctionDescribe drops the cached describe for a function
+// addressed by ARN - the describe result carries tags, so tag writes must
+// invalidate it. The ARN's last segment is the function name.
+func (c *Client) invalidateFunctionDescribe(arn string) {
+	if i := strings.LastIndex(arn, ":"); i >= 0 {
+		c.describes.invalidate(fnDescribeCacheKey(arn[i+1:]))
+	}
+}
+
 func (c *Client) DescribeFunction(ctx context.Context, functionName string) (*model.Function, error) {
+	cacheKey := fnDescribeCacheKey(functionName)
+	if cached, ok := c.describes.get(cacheKey); ok {
+		fn := cached.(model.Function)
+		return &fn, nil
+	}
+
 	out, err := c.lambda.GetFunction(ctx, &lambda.GetFunctionInput{
 		FunctionName: aws.String(functionName),
 	})
@@ -68,18 +90,280 @@ func (c *Client) DescribeFunction(ctx context.Context, functionName string) (*mo
 	}
 
 	fn := convertFunctionConfig(*out.Configuration)
+	fn.Tags = out.Tags
+	c.describes.put(cacheKey, fn)
 	return &fn, nil
 }
 
+// GetFunctionCodeLocation returns where a function's code lives: the
+// presigned S3 URL of the deployment package for zip functions, or the ECR
+// image URI for image-based ones.
+func (c *Client) GetFunctionCodeLocation(ctx context.Context, name string) (string, error) {
+	out, err := c.lambda.GetFunction(ctx, &lambda.GetFunctionInput{
+		FunctionName: aws.String(name),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get function %s: %w", name, err)
+	}
+	if out.Code == nil {
+		return "", fmt.Errorf("no code metadata for %s", name)
+	}
+	if uri := aws.ToString(out.Code.ImageUri); uri != "" {
+		return uri, nil
+	}
+	if loc := aws.ToString(out.Code.Location); loc != "" {
+		return loc, nil
+	}
+	return "", fmt.Errorf("no code location for %s", name)
+}
+
+// GetFunctionUrlConfig returns a function's URL configuration, or nil (no
+// error) when the function has no URL configured.
+func (c *Client) GetFunctionUrlConfig(ctx context.Context, name string) (*model.FunctionUrl, error) {
+	out, err := c.lambda.GetFunctionUrlConfig(ctx, &lambda.GetFunctionUrlConfigInput{
+		FunctionName: aws.String(name),
+	})
+	if err != nil {
+		var notFound *types.ResourceNotFoundException
+		if errors.As(err, &notFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get function URL config for %s: %w", name, err)
+	}
+
+	cfg := &model.FunctionUrl{
+		URL:      aws.ToString(out.FunctionUrl),
+		AuthType: string(out.AuthType),
+	}
+	if out.Cors != nil {
+		cfg.AllowOrigins = out.Cors.AllowOrigins
+		cfg.AllowMethods = out.Cors.AllowMethods
+	}
+	return cfg, nil
+}
+
+// GetFunctionTags returns the tags on a function. Lambda tagging is
+// ARN-addressed, unlike the name-addressed per-function calls.
+func (c *Client) GetFunctionTags(ctx context.Context, arn string) (map[string]string, error) {
+	out, err := c.lambda.ListTags(ctx, &lambda.ListTagsInput{
+		Resource: aws.String(arn),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list function tags: %w", err)
+	}
+	return out.Tags, nil
+}
+
+// TagFunction adds or updates tags on a function by ARN.
+func (c *Client) TagFunction(ctx context.Context, arn string, tags map[string]string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+	_, err := c.lambda.TagResource(ctx, &lambda.TagResourceInput{
+		Resource: aws.String(arn),
+		Tags:     tags,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to tag function: %w", err)
+	}
+	c.invalidateFunctionDescribe(arn)
+	return nil
+}
+
+// UntagFunction removes tags from a function by ARN.
+func (c *Client) UntagFunction(ctx context.Context, arn string, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	_, err := c.lambda.UntagResource(ctx, &lambda.UntagResourceInput{
+		Resource: aws.String(arn),
+		TagKeys:  keys,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to untag function: %w", err)
+	}
+	c.invalidateFunctionDescribe(arn)
+	return nil
+}
+
+// UpdateFunctionConfig updates a function's memory size and timeout, then
+// polls GetFunction until LastUpdateStatus reports Successful so the
+// returned configuration reflects the applied values.
+func (c *Client) UpdateFunctionConfig(ctx context.Context, name string, memoryMB, timeoutSec int) (*model.Function, error) {
+	_, err := c.lambda.UpdateFunctionConfiguration(ctx, &lambda.UpdateFunctionConfigurationInput{
+		FunctionName: aws.String(name),
+		MemorySize:   aws.Int32(int32(memoryMB)),
+		Timeout:      aws.Int32(int32(timeoutSec)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update function %s: %w", name, err)
+	}
+	c.describes.invalidate(fnDescribeCacheKey(name))
+
+	for i := 0; i < 30; i++ {
+		out, err := c.lambda.GetFunction(ctx, &lambda.GetFunctionInput{
+			FunctionName: aws.String(name),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to refresh function %s: %w", name, err)
+		}
+
+		switch out.Configuration.LastUpdateStatus {
+		case types.LastUpdateStatusSuccessful:
+			fn := convertFunctionConfig(*out.Configuration)
+			fn.Tags = out.Tags
+			return &fn, nil
+		case types.LastUpdateStatusFailed:
+			return nil, fmt.Errorf("function update failed: %s", aws.ToString(out.Configuration.LastUpdateStatusReason))
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(time.Second):
+		}
+	}
+	return nil, fmt.Errorf("timed out waiting for function update to complete")
+}
+
+// UpdateFunctionEnvironment replaces a function's environment variables.
+func (c *Client) UpdateFunctionEnvironment(ctx context.Context, name string, env map[string]string) error {
+	_, err := c.lambda.UpdateFunctionConfiguration(ctx, &lambda.UpdateFunctionConfigurationInput{
+		FunctionName: aws.String(name),
+		Environment:  &types.Environment{Variables: env},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update environment for %s: %w", name, err)
+	}
+
+	// The cached config now has stale variables
+	c.describes.invalidate(fnDescribeCacheKey(name))
+	return nil
+}
+
+// ListAliases lists a function's aliases with their routing configuration.
+func (c *Client) ListAliases(ctx context.Context, functionName string) ([]model.LambdaAlias, error) {
+	var aliases []model.LambdaAlias
+
+	paginator := lambda.NewListAliasesPaginator(c.lambda, &lambda.ListAliasesInput{
+		FunctionName: aws.String(functionName),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list aliases for %s: %w", functionName, err)
+		}
+		for _, a := range page.Aliases {
+			alias := model.LambdaAlias{
+				Name:            aws.ToString(a.Name),
+				FunctionVersion: aws.ToString(a.FunctionVersion),
+				Description:     aws.ToString(a.Description),
+			}
+			if a.RoutingConfig != nil && len(a.RoutingConfig.AdditionalVersionWeights) > 0 {
+				alias.RoutingWeights = a.RoutingConfig.AdditionalVersionWeights
+			}
+			aliases = append(aliases, alias)
+		}
+	}
+	return aliases, nil
+}
+
+// ListVersions lists a function's published versions, including $LATEST.
+func (c *Client) ListVersions(ctx context.Context, functionName string) ([]model.LambdaVersion, error) {
+	var versions []model.LambdaVersion
+
+	paginator := lambda.NewListVersionsByFunctionPaginator(c.lambda, &lambda.ListVersionsByFunctionInput{
+		FunctionName: aws.String(functionName),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list versions for %s: %w", functionName, err)
+		}
+		for _, v := range page.Versions {
+			version := model.LambdaVersion{
+				Version:     aws.ToString(v.Version),
+				Description: aws.ToString(v.Description),
+				CodeSize:    v.CodeSize,
+			}
+			if v.LastModified != nil {
+				if t, err := time.Parse("2006-01-02T15:04:05.000+0000", *v.LastModified); err == nil {
+					version.LastModified = t
+				}
+			}
+			versions = append(versions, version)
+		}
+	}
+	return versions, nil
+}
+
+// ListEventSourceMappings lists the event sources triggering a function.
+func (c *Client) ListEventSourceMappings(ctx context.Context, functionName string) ([]model.EventSourceMapping, error) {
+	var mappings []model.EventSourceMapping
+
+	paginator := lambda.NewListEventSourceMappingsPaginator(c.lambda, &lambda.ListEventSourceMappingsInput{
+		FunctionName: aws.String(functionName),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list event source mappings for %s: %w", functionName, err)
+		}
+		for _, esm := range page.EventSourceMappings {
+			mappings = append(mappings, model.EventSourceMapping{
+				UUID:                     aws.ToString(esm.UUID),
+				EventSourceARN:           aws.ToString(esm.EventSourceArn),
+				State:                    aws.ToString(esm.State),
+				BatchSize:                int(aws.ToInt32(esm.BatchSize)),
+				MaxBatchingWindowSeconds: int(aws.ToInt32(esm.MaximumBatchingWindowInSeconds)),
+			})
+		}
+	}
+	return mappings, nil
+}
+
+// SetEventSourceMappingEnabled enables or disables an event source mapping.
+// The mapping transitions through Enabling/Disabling before settling.
+func (c *Client) SetEventSourceMappingEnabled(ctx context.Context, uuid string, enabled bool) error {
+	_, err := c.lambda.UpdateEventSourceMapping(ctx, &lambda.UpdateEventSourceMappingInput{
+		UUID:    aws.String(uuid),
+		Enabled: aws.Bool(enabled),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update event source mapping %s: %w", uuid, err)
+	}
+	return nil
+}
+
+// GetEventSourceMappingState returns a mapping's current state.
+func (c *Client) GetEventSourceMappingState(ctx context.Context, uuid string) (string, error) {
+	out, err := c.lambda.GetEventSourceMapping(ctx, &lambda.GetEventSourceMappingInput{
+		UUID: aws.String(uuid),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get event source mapping %s: %w", uuid, err)
+	}
+	return aws.ToString(out.State), nil
+}
+
 // InvokeFunction invokes a Lambda function with the given payload.
 // Returns the invocation result including response payload and execution metadata.
 func (c *Client) InvokeFunction(ctx context.Context, functionName, payload string) (*model.InvocationResult, error) {
+	return c.InvokeFunctionQualified(ctx, functionName, "", payload)
+}
+
+// InvokeFunctionQualified invokes a specific alias or version by setting
+// Qualifier on the request. An empty qualifier targets $LATEST.
+func (c *Client) InvokeFunctionQualified(ctx context.Context, functionName, qualifier, payload string) (*model.InvocationResult, error) {
 	start := time.Now()
 
 	input := &lambda.InvokeInput{
 		FunctionName: aws.String(functionName),
 		LogType:      types.LogTypeTail, // Get last 4KB of execution log
 	}
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
 
 	// Only set payload if non-empty
 	if payload != "" {
@@ -113,6 +397,28 @@ func (c *Client) InvokeFunction(ctx context.Context, functionName, payload strin
 	return result, nil
 }
 
+// InvokeFunctionAsync invokes a function with InvocationType Event,
+// returning once the request is accepted (202). Event invocations carry no
+// response payload; output goes to the function's CloudWatch logs.
+func (c *Client) InvokeFunctionAsync(ctx context.Context, functionName, payload string) error {
+	input := &lambda.InvokeInput{
+		FunctionName:   aws.String(functionName),
+		InvocationType: types.InvocationTypeEvent,
+	}
+	if payload != "" {
+		input.Payload = []byte(payload)
+	}
+
+	out, err := c.lambda.Invoke(ctx, input)
+	if err != nil {
+		return fmt.Errorf("failed to invoke function %s: %w", functionName, err)
+	}
+	if out.StatusCode != 202 {
+		return fmt.Errorf("unexpected status %d for async invocation", out.StatusCode)
+	}
+	return nil
+}
+
 // convertFunction converts an AWS Lambda function configuration to our model.
 func convertFunction(fn types.FunctionConfiguration) model.Function {
 	return convertFunctionConfig(fn)
@@ -133,6 +439,20 @@ func convertFunctionConfig(fn types.FunctionConfiguration) model.Function {
 		PackageType: string(fn.PackageType),
 	}
 
+	// Environment variables
+	if fn.Environment != nil {
+		function.Environment = fn.Environment.Variables
+	}
+
+	// VPC attachment (present in ListFunctions output, no extra call needed)
+	if fn.VpcConfig != nil {
+		function.VpcConfig = model.VPCConfig{
+			VpcID:            aws.ToString(fn.VpcConfig.VpcId),
+			SubnetIDs:        fn.VpcConfig.SubnetIds,
+			SecurityGroupIDs: fn.VpcConfig.SecurityGroupIds,
+		}
+	}
+
 	// Parse LastModified timestamp
 	if fn.LastModified != nil {
 		if t, err := time.Parse("2006-01-02T15:04:05.000+0000", *fn.LastModified); err == nil {
@@ -0,0 +1,29 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ProbeRegionLatency measures round-trip latency to a region by timing an
+// HTTPS request against the regional STS endpoint. No credentials are needed;
+// any HTTP response counts as a successful round trip.
+func ProbeRegionLatency(ctx context.Context, region string) (time.Duration, error) {
+	url := fmt.Sprintf("https://sts.%s.amazonaws.com/", region)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	start := time.Now()
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to reach %s: %w", region, err)
+	}
+	resp.Body.Close()
+
+	return time.Since(start), nil
+}
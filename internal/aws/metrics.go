@@ -0,0 +1,50 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+
+	"vaws/internal/log"
+	"vaws/internal/model"
+)
+
+// GetQueueMetrics returns a queue's visible-message depth over the trailing
+// period at one-minute resolution, oldest first.
+func (c *Client) GetQueueMetrics(ctx context.Context, queueName string, period time.Duration) ([]model.MetricPoint, error) {
+	log.Debug("Fetching depth history for queue %s", queueName)
+
+	end := time.Now()
+	out, err := c.cw.GetMetricStatistics(ctx, &cloudwatch.GetMetricStatisticsInput{
+		Namespace:  aws.String("AWS/SQS"),
+		MetricName: aws.String("ApproximateNumberOfMessagesVisible"),
+		Dimensions: []cwtypes.Dimension{{
+			Name:  aws.String("QueueName"),
+			Value: aws.String(queueName),
+		}},
+		StartTime:  aws.Time(end.Add(-period)),
+		EndTime:    aws.Time(end),
+		Period:     aws.Int32(60),
+		Statistics: []cwtypes.Statistic{cwtypes.StatisticAverage},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get queue metrics: %w", err)
+	}
+
+	points := make([]model.MetricPoint, 0, len(out.Datapoints))
+	for _, dp := range out.Datapoints {
+		points = append(points, model.MetricPoint{
+			Timestamp: aws.ToTime(dp.Timestamp),
+			Value:     aws.ToFloat64(dp.Average),
+		})
+	}
+	sort.Slice(points, func(i, j int) bool {
+		return points[i].Timestamp.Before(points[j].Timestamp)
+	})
+	return points, nil
+}
@@ -0,0 +1,83 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+
+	"github.com/aws/smithy-go"
+)
+
+// Built-in retry policy for throttled per-item calls; large accounts hit
+// SQS/Lambda rate limits when fetching attributes in parallel.
+const (
+	defaultRetryMaxAttempts = 3
+	defaultRetryBaseDelay   = 200 * time.Millisecond
+	maxRetryDelay           = 10 * time.Second
+)
+
+var (
+	retryMaxAttempts = defaultRetryMaxAttempts
+	retryBaseDelay   = defaultRetryBaseDelay
+)
+
+// SetRetryPolicy configures the retry wrapper from config. Zero values keep
+// the built-in defaults.
+func SetRetryPolicy(maxAttempts int, baseDelay time.Duration) {
+	if maxAttempts > 0 {
+		retryMaxAttempts = maxAttempts
+	}
+	if baseDelay > 0 {
+		retryBaseDelay = baseDelay
+	}
+}
+
+// isRetryable reports whether err is throttling or a timeout - worth
+// retrying - as opposed to 4xx-class failures like access denied.
+func isRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.ErrorCode() {
+		case "Throttling", "ThrottlingException", "TooManyRequestsException",
+			"RequestThrottled", "RequestLimitExceeded", "SlowDown":
+			return true
+		}
+	}
+	return false
+}
+
+// WithRetry runs fn, retrying throttled or timed-out attempts with jittered
+// exponential backoff. Non-retryable errors (and context cancellation) are
+// returned immediately.
+func WithRetry(ctx context.Context, fn func() error) error {
+	var err error
+	for attempt := 0; attempt < retryMaxAttempts; attempt++ {
+		if attempt > 0 {
+			delay := retryBaseDelay << (attempt - 1)
+			if delay <= 0 || delay > maxRetryDelay { // shift overflow or runaway config
+				delay = maxRetryDelay
+			}
+			delay += time.Duration(rand.Int63n(int64(delay))) // full jitter on top
+
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return err
+			}
+		}
+
+		err = fn()
+		if err == nil || !isRetryable(err) {
+			return err
+		}
+	}
+	return err
+}
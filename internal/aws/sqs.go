@@ -3,6 +3,7 @@ package aws
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"sort"
 	"strconv"
@@ -67,7 +68,13 @@ func (c *Client) ListQueues(ctx context.Context) ([]model.Queue, error) {
 			sem <- struct{}{}        // Acquire semaphore
 			defer func() { <-sem }() // Release semaphore
 
-			queue, err := c.GetQueueAttributes(ctx, queueURL)
+			// Retry throttled fetches so queues don't silently drop out
+			var queue *model.Queue
+			err := WithRetry(ctx, func() error {
+				var err error
+				queue, err = c.GetQueueAttributes(ctx, queueURL)
+				return err
+			})
 			results <- queueResult{index: idx, queue: queue, err: err}
 		}(i, url)
 	}
@@ -108,8 +115,20 @@ func (c *Client) ListQueues(ctx context.Context) ([]model.Queue, error) {
 	return validQueues, nil
 }
 
+// queueAttrsCacheKey is the describe-cache key for a queue's attributes.
+func queueAttrsCacheKey(queueURL string) string {
+	return "sqs:attrs:" + queueURL
+}
+
 // GetQueueAttributes returns detailed information about a specific queue.
+// Results are served from the describe cache within its TTL.
 func (c *Client) GetQueueAttributes(ctx context.Context, queueURL string) (*model.Queue, error) {
+	cacheKey := queueAttrsCacheKey(queueURL)
+	if cached, ok := c.describes.get(cacheKey); ok {
+		queue := cached.(model.Queue)
+		return &queue, nil
+	}
+
 	log.Debug("Getting attributes for SQS queue: %s", queueURL)
 
 	out, err := c.sqs.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
@@ -122,7 +141,50 @@ func (c *Client) GetQueueAttributes(ctx context.Context, queueURL string) (*mode
 		return nil, fmt.Errorf("failed to get queue attributes: %w", err)
 	}
 
-	return convertQueueAttributes(queueURL, out.Attributes), nil
+	queue := convertQueueAttributes(queueURL, out.Attributes)
+	c.describes.put(cacheKey, *queue)
+	return queue, nil
+}
+
+// GetQueueTags returns the tags on a queue.
+func (c *Client) GetQueueTags(ctx context.Context, queueURL string) (map[string]string, error) {
+	out, err := c.sqs.ListQueueTags(ctx, &sqs.ListQueueTagsInput{
+		QueueUrl: aws.String(queueURL),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list queue tags: %w", err)
+	}
+	return out.Tags, nil
+}
+
+// TagQueue adds or updates tags on a queue.
+func (c *Client) TagQueue(ctx context.Context, queueURL string, tags map[string]string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+	_, err := c.sqs.TagQueue(ctx, &sqs.TagQueueInput{
+		QueueUrl: aws.String(queueURL),
+		Tags:     tags,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to tag queue: %w", err)
+	}
+	return nil
+}
+
+// UntagQueue removes tags from a queue.
+func (c *Client) UntagQueue(ctx context.Context, queueURL string, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	_, err := c.sqs.UntagQueue(ctx, &sqs.UntagQueueInput{
+		QueueUrl: aws.String(queueURL),
+		TagKeys:  keys,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to untag queue: %w", err)
+	}
+	return nil
 }
 
 // GetQueuesFromStack returns SQS queue URLs from a CloudFormation stack.
@@ -182,6 +244,11 @@ func convertQueueAttributes(url string, attrs map[string]string) *model.Queue {
 		queue.DelaySeconds, _ = strconv.Atoi(val)
 	}
 
+	// Resource (access) policy for cross-account/SNS setups
+	if val, ok := attrs[string(sqstypes.QueueAttributeNamePolicy)]; ok {
+		queue.Policy = val
+	}
+
 	// Parse created timestamp
 	if val, ok := attrs[string(sqstypes.QueueAttributeNameCreatedTimestamp)]; ok {
 		if ts, err := strconv.ParseInt(val, 10, 64); err == nil {
@@ -214,6 +281,277 @@ func extractQueueNameFromURL(url string) string {
 	return url
 }
 
+// PeekMessages reads up to max messages without consuming them: a very
+// short visibility timeout returns them to the queue almost immediately.
+func (c *Client) PeekMessages(ctx context.Context, queueURL string, max int) ([]model.Message, error) {
+	log.Debug("Peeking up to %d messages from %s", max, queueURL)
+
+	var messages []model.Message
+	seen := make(map[string]bool)
+
+	for len(messages) < max {
+		batch := int32(max - len(messages))
+		if batch > 10 {
+			batch = 10 // ReceiveMessage maximum
+		}
+
+		out, err := c.sqs.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
+			QueueUrl:              aws.String(queueURL),
+			MaxNumberOfMessages:   batch,
+			VisibilityTimeout:     1, // messages reappear after a second
+			WaitTimeSeconds:       1,
+			AttributeNames:        []sqstypes.QueueAttributeName{sqstypes.QueueAttributeNameAll},
+			MessageAttributeNames: []string{"All"},
+		})
+		if err != nil {
+			// A partial read beats an error: return what's collected when
+			// the caller's context ran out mid-peek
+			if len(messages) > 0 && ctx.Err() != nil {
+				log.Warn("Peek stopped early after %d message(s): %v", len(messages), err)
+				break
+			}
+			return nil, fmt.Errorf("failed to receive messages: %w", err)
+		}
+		if len(out.Messages) == 0 {
+			break
+		}
+
+		added := false
+		for _, msg := range out.Messages {
+			id := aws.ToString(msg.MessageId)
+			if seen[id] {
+				continue // the short visibility timeout can hand a message back
+			}
+			seen[id] = true
+			added = true
+
+			message := model.Message{
+				MessageID:     id,
+				Body:          aws.ToString(msg.Body),
+				ReceiptHandle: aws.ToString(msg.ReceiptHandle),
+				Attributes:    msg.Attributes,
+			}
+			if v, ok := msg.Attributes["ApproximateReceiveCount"]; ok {
+				message.ApproximateReceiveCount, _ = strconv.Atoi(v)
+			}
+			if len(msg.MessageAttributes) > 0 {
+				message.MessageAttributes = make(map[string]string, len(msg.MessageAttributes))
+				for name, attr := range msg.MessageAttributes {
+					value := aws.ToString(attr.StringValue)
+					if value == "" && len(attr.BinaryValue) > 0 {
+						value = fmt.Sprintf("<%d binary bytes>", len(attr.BinaryValue))
+					}
+					message.MessageAttributes[name] = fmt.Sprintf("%s (%s)", value, aws.ToString(attr.DataType))
+				}
+			}
+			messages = append(messages, message)
+		}
+
+		// Only redeliveries this round: everything visible has been read,
+		// so fewer than max messages exist - don't spin until the context
+		// dies re-receiving the same ones
+		if !added {
+			break
+		}
+	}
+
+	log.Info("Peeked %d message(s)", len(messages))
+	return messages, nil
+}
+
+// ErrMessageNotVisible reports that a targeted message could not be
+// re-received for deletion; it may be in flight with another consumer or
+// already deleted.
+var ErrMessageNotVisible = errors.New("message is not currently visible (it may be in flight or already deleted)")
+
+// DeleteMessage removes a message from a queue by receipt handle.
+func (c *Client) DeleteMessage(ctx context.Context, queueURL, receiptHandle string) error {
+	log.Debug("Deleting message from %s", queueURL)
+
+	_, err := c.sqs.DeleteMessage(ctx, &sqs.DeleteMessageInput{
+		QueueUrl:      aws.String(queueURL),
+		ReceiptHandle: aws.String(receiptHandle),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete message: %w", err)
+	}
+	return nil
+}
+
+// DeleteMessageByID re-receives messages until it finds messageID and
+// deletes it with the fresh receipt handle - handles from a short-visibility
+// peek expire within a second, so they can't be used directly. Non-matching
+// messages get a 1 second visibility timeout so they reappear immediately.
+func (c *Client) DeleteMessageByID(ctx context.Context, queueURL, messageID string) error {
+	deadline := time.Now().Add(10 * time.Second)
+
+	for time.Now().Before(deadline) {
+		out, err := c.sqs.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
+			QueueUrl:            aws.String(queueURL),
+			MaxNumberOfMessages: 10,
+			VisibilityTimeout:   1,
+			WaitTimeSeconds:     1,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to re-receive messages: %w", err)
+		}
+
+		for _, msg := range out.Messages {
+			if aws.ToString(msg.MessageId) == messageID {
+				return c.DeleteMessage(ctx, queueURL, aws.ToString(msg.ReceiptHandle))
+			}
+		}
+	}
+	return ErrMessageNotVisible
+}
+
+// ErrPurgeInProgress reports that a queue purge was rejected because one is
+// already running; AWS allows a single purge per queue per 60 seconds.
+var ErrPurgeInProgress = errors.New("a purge is already in progress for this queue (AWS allows one per 60s)")
+
+// PurgeQueue deletes every message in a queue. A purge already in progress
+// surfaces as ErrPurgeInProgress rather than a raw API error.
+func (c *Client) PurgeQueue(ctx context.Context, queueURL string) error {
+	log.Debug("Purging queue %s", queueURL)
+
+	_, err := c.sqs.PurgeQueue(ctx, &sqs.PurgeQueueInput{
+		QueueUrl: aws.String(queueURL),
+	})
+	if err != nil {
+		var inProgress *sqstypes.PurgeQueueInProgress
+		if errors.As(err, &inProgress) {
+			return ErrPurgeInProgress
+		}
+		return fmt.Errorf("failed to purge queue: %w", err)
+	}
+
+	// The cached counts are now wrong; the next describe must hit the API
+	c.describes.invalidate(queueAttrsCacheKey(queueURL))
+	return nil
+}
+
+// SendMessage sends a message to a queue with optional string message
+// attributes, returning the MessageId.
+func (c *Client) SendMessage(ctx context.Context, queueURL, body string, attrs map[string]string) (string, error) {
+	return c.sendMessage(ctx, queueURL, body, attrs, "", "")
+}
+
+// SendFIFOMessage sends to a FIFO queue, which requires a MessageGroupId and
+// accepts an optional MessageDeduplicationId.
+func (c *Client) SendFIFOMessage(ctx context.Context, queueURL, body string, attrs map[string]string, groupID, dedupID string) (string, error) {
+	return c.sendMessage(ctx, queueURL, body, attrs, groupID, dedupID)
+}
+
+func (c *Client) sendMessage(ctx context.Context, queueURL, body string, attrs map[string]string, groupID, dedupID string) (string, error) {
+	log.Debug("Sending message to %s", queueURL)
+
+	input := &sqs.SendMessageInput{
+		QueueUrl:    aws.String(queueURL),
+		MessageBody: aws.String(body),
+	}
+
+	if len(attrs) > 0 {
+		input.MessageAttributes = make(map[string]sqstypes.MessageAttributeValue, len(attrs))
+		for name, value := range attrs {
+			input.MessageAttributes[name] = sqstypes.MessageAttributeValue{
+				DataType:    aws.String("String"),
+				StringValue: aws.String(value),
+			}
+		}
+	}
+
+	if groupID != "" {
+		input.MessageGroupId = aws.String(groupID)
+	}
+	if dedupID != "" {
+		input.MessageDeduplicationId = aws.String(dedupID)
+	}
+
+	out, err := c.sqs.SendMessage(ctx, input)
+	if err != nil {
+		return "", fmt.Errorf("failed to send message: %w", err)
+	}
+
+	// Drop the cached attributes so the post-send count refresh doesn't
+	// serve the pre-send snapshot
+	c.describes.invalidate(queueAttrsCacheKey(queueURL))
+	return aws.ToString(out.MessageId), nil
+}
+
+// StartMessageMoveTask starts redriving messages from a DLQ back to the
+// source queue(s) recorded in its redrive relationships. maxPerSecond 0
+// leaves the rate unlimited. Returns the task handle used for tracking
+// and cancellation.
+func (c *Client) StartMessageMoveTask(ctx context.Context, sourceARN string, maxPerSecond int32) (string, error) {
+	log.Debug("Starting message move task from %s", sourceARN)
+
+	input := &sqs.StartMessageMoveTaskInput{
+		SourceArn: aws.String(sourceARN),
+	}
+	if maxPerSecond > 0 {
+		input.MaxNumberOfMessagesPerSecond = aws.Int32(maxPerSecond)
+	}
+
+	out, err := c.sqs.StartMessageMoveTask(ctx, input)
+	if err != nil {
+		return "", fmt.Errorf("failed to start message move task: %w", err)
+	}
+	return aws.ToString(out.TaskHandle), nil
+}
+
+// StartDLQRedrive starts moving a DLQ's messages back to the source
+// queue(s) recorded in its redrive relationships at an unlimited rate,
+// returning the task handle for progress tracking.
+func (c *Client) StartDLQRedrive(ctx context.Context, dlqArn string) (string, error) {
+	return c.StartMessageMoveTask(ctx, dlqArn, 0)
+}
+
+// CancelMessageMoveTask cancels an in-flight DLQ message move task.
+// Returns the approximate number of messages already moved before the cancel.
+func (c *Client) CancelMessageMoveTask(ctx context.Context, taskHandle string) (int64, error) {
+	log.Debug("Cancelling message move task %s", taskHandle)
+
+	out, err := c.sqs.CancelMessageMoveTask(ctx, &sqs.CancelMessageMoveTaskInput{
+		TaskHandle: aws.String(taskHandle),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to cancel message move task: %w", err)
+	}
+	return out.ApproximateNumberOfMessagesMoved, nil
+}
+
+// ListMessageMoveTasks returns recent message move tasks for a source ARN.
+func (c *Client) ListMessageMoveTasks(ctx context.Context, sourceARN string) ([]model.MessageMoveTask, error) {
+	log.Debug("Listing message move tasks for %s", sourceARN)
+
+	out, err := c.sqs.ListMessageMoveTasks(ctx, &sqs.ListMessageMoveTasksInput{
+		SourceArn:  aws.String(sourceARN),
+		MaxResults: aws.Int32(10),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list message move tasks: %w", err)
+	}
+
+	var tasks []model.MessageMoveTask
+	for _, e := range out.Results {
+		task := model.MessageMoveTask{
+			TaskHandle:     aws.ToString(e.TaskHandle),
+			Status:         aws.ToString(e.Status),
+			SourceARN:      aws.ToString(e.SourceArn),
+			DestinationARN: aws.ToString(e.DestinationArn),
+			MovedCount:     e.ApproximateNumberOfMessagesMoved,
+			TotalCount:     aws.ToInt64(e.ApproximateNumberOfMessagesToMove),
+			MaxPerSecond:   int(aws.ToInt32(e.MaxNumberOfMessagesPerSecond)),
+			FailureReason:  aws.ToString(e.FailureReason),
+		}
+		if e.StartedTimestamp > 0 {
+			task.StartedAt = time.UnixMilli(e.StartedTimestamp)
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
 // ListQueuesPagedCallback lists SQS queues with a callback for each batch.
 // This enables lazy loading by delivering results incrementally.
 // The callback receives queues from each batch and returns true to continue or false to stop.
@@ -266,7 +604,13 @@ func (c *Client) fetchQueueAttributesBatch(ctx context.Context, queueURLs []stri
 			sem <- struct{}{}
 			defer func() { <-sem }()
 
-			queue, err := c.GetQueueAttributes(ctx, queueURL)
+			// Retry throttled fetches so queues don't silently drop out
+			var queue *model.Queue
+			err := WithRetry(ctx, func() error {
+				var err error
+				queue, err = c.GetQueueAttributes(ctx, queueURL)
+				return err
+			})
 			results <- queueResult{index: idx, queue: queue, err: err}
 		}(i, url)
 	}
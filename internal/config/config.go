@@ -2,13 +2,43 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	"sync"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
 
+var (
+	regionRe = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`)
+	vpceRe   = regexp.MustCompile(`^vpce-[0-9a-f]+$`)
+)
+
+// IsValidRegion reports whether s looks like an AWS region code.
+func IsValidRegion(s string) bool {
+	return regionRe.MatchString(s)
+}
+
+// IsValidVPCEndpointID reports whether s looks like a VPC endpoint id.
+func IsValidVPCEndpointID(s string) bool {
+	return vpceRe.MatchString(s)
+}
+
+// DefaultChangeHighlightTTL is how long refresh-diff highlights persist
+// in list views when change_highlight_seconds is not configured.
+const DefaultChangeHighlightTTL = 10 * time.Second
+
+// DefaultLogFetchTimeout bounds a single CloudWatch log fetch when
+// log_fetch_timeout_seconds is not configured.
+const DefaultLogFetchTimeout = 30 * time.Second
+
+// DefaultReconnectBackoff is the initial tunnel reconnect delay when
+// tunnels.reconnect_backoff_seconds is not configured.
+const DefaultReconnectBackoff = 2 * time.Second
+
 // Config represents the application configuration
 type Config struct {
 	// Profiles contains profile-specific settings
@@ -16,6 +46,98 @@ type Config struct {
 
 	// Defaults contains default settings applied to all profiles
 	Defaults DefaultConfig `yaml:"defaults"`
+
+	// QueryTemplates are named, parameterized DynamoDB query definitions.
+	// Values starting with ":" are placeholders prompted for at run time.
+	QueryTemplates []QueryTemplate `yaml:"query_templates,omitempty"`
+
+	// Filters are default include/exclude rules applied after each load,
+	// keyed by resource type: lambda, sqs, dynamodb, stacks, ecs
+	Filters map[string]FilterRules `yaml:"filters,omitempty"`
+
+	// Tunnels controls SSM tunnel auto-reconnect behavior
+	Tunnels TunnelsConfig `yaml:"tunnels,omitempty"`
+
+	// CustomRegions are region codes entered by hand in the region selector
+	// (GovCloud, newly launched regions), offered again in later sessions
+	CustomRegions []string `yaml:"custom_regions,omitempty"`
+
+	// AutoRefresh sets per-resource auto-refresh intervals in seconds,
+	// keyed by resource type: stacks, ecs, lambda, sqs, dynamodb
+	// (0 = off; stacks and ecs default to the built-in 10s when unset)
+	AutoRefresh map[string]int `yaml:"auto_refresh,omitempty"`
+
+	// Retry controls retry-with-backoff for throttled per-item calls
+	Retry RetryConfig `yaml:"retry,omitempty"`
+
+	// Keymap overrides action keybindings, applied over the defaults
+	Keymap KeymapConfig `yaml:"keymap,omitempty"`
+}
+
+// KeymapConfig customizes keybindings. The preset applies first ("vim" adds
+// h/l and keeps j/k/g/G), then per-action bindings override individually.
+// Actions: up, down, left, right, select, back, top, bottom, refresh,
+// filter, logs, help, quit.
+type KeymapConfig struct {
+	Preset   string              `yaml:"preset,omitempty"`
+	Bindings map[string][]string `yaml:"bindings,omitempty"`
+}
+
+// RetryConfig tunes the retry wrapper around per-item calls that large
+// accounts get throttled on (queue attributes, function describes).
+type RetryConfig struct {
+	// MaxAttempts is the total number of tries per call
+	// (0 uses the built-in 3)
+	MaxAttempts int `yaml:"max_attempts,omitempty"`
+
+	// BaseDelayMillis is the delay before the first retry; each further
+	// retry doubles it with jitter (0 uses the built-in 200ms)
+	BaseDelayMillis int `yaml:"base_delay_ms,omitempty"`
+}
+
+// TunnelsConfig controls automatic reconnection of SSM tunnels whose
+// underlying session dies unexpectedly (network blips, replaced creds).
+type TunnelsConfig struct {
+	// ReconnectAttempts is how many times a dead tunnel is re-established
+	// before it is marked as errored (0 disables auto-reconnect, the default)
+	ReconnectAttempts int `yaml:"reconnect_attempts,omitempty"`
+
+	// ReconnectBackoffSeconds is the delay before the first reconnect
+	// attempt; each further attempt doubles it (0 uses the built-in 2s)
+	ReconnectBackoffSeconds int `yaml:"reconnect_backoff_seconds,omitempty"`
+}
+
+// FilterRules are default include/exclude name patterns for one view.
+// Patterns with glob metacharacters use glob matching; plain strings match
+// as case-insensitive substrings.
+type FilterRules struct {
+	Include []string `yaml:"include,omitempty"` // when set, only matching names show
+	Exclude []string `yaml:"exclude,omitempty"` // matching names are hidden
+}
+
+// QueryTemplate is a named, shareable parameterized DynamoDB query.
+// Placeholder values (e.g. ":pk") are filled in when the template runs.
+type QueryTemplate struct {
+	Name             string `yaml:"name"`
+	Table            string `yaml:"table"`
+	IndexName        string `yaml:"index_name,omitempty"`
+	PartitionKeyName string `yaml:"partition_key,omitempty"` // Defaults to the table's PK
+	PartitionKeyVal  string `yaml:"partition_value"`
+	SortKeyName      string `yaml:"sort_key,omitempty"` // Defaults to the table's SK
+	SortKeyVal       string `yaml:"sort_value,omitempty"`
+	SortKeyCondition string `yaml:"sort_condition,omitempty"` // =, <, <=, >, >=, begins_with
+	Limit            int32  `yaml:"limit,omitempty"`
+}
+
+// TemplatesForTable returns the query templates defined for a table.
+func (c *Config) TemplatesForTable(table string) []QueryTemplate {
+	var out []QueryTemplate
+	for _, t := range c.QueryTemplates {
+		if t.Table == table {
+			out = append(out, t)
+		}
+	}
+	return out
 }
 
 // ProfileConfig contains settings for a specific AWS profile
@@ -36,6 +158,9 @@ type ProfileConfig struct {
 
 // DefaultConfig contains default settings
 type DefaultConfig struct {
+	// Profile used when --profile is not passed on the command line
+	Profile string `yaml:"profile,omitempty"`
+
 	// JumpHostTags are tags to search for when auto-discovering jump hosts
 	// Priority order: first match wins
 	JumpHostTags []string `yaml:"jump_host_tags,omitempty"`
@@ -43,6 +168,87 @@ type DefaultConfig struct {
 	// JumpHostNames are instance names to search for when auto-discovering
 	// Priority order: first match wins
 	JumpHostNames []string `yaml:"jump_host_names,omitempty"`
+
+	// ChangeHighlightSeconds is how long refresh-diff highlights persist
+	// in list views (0 uses the built-in default)
+	ChangeHighlightSeconds int `yaml:"change_highlight_seconds,omitempty"`
+
+	// LogFetchTimeoutSeconds bounds a single CloudWatch log fetch
+	// (0 uses the built-in default)
+	LogFetchTimeoutSeconds int `yaml:"log_fetch_timeout_seconds,omitempty"`
+
+	// DescribeCacheTTLSeconds is how long describe-style results (queue
+	// attributes, function configs, API details) are served from memory
+	// (0 uses the built-in 60s; -1 disables the cache)
+	DescribeCacheTTLSeconds int `yaml:"describe_cache_ttl_seconds,omitempty"`
+
+	// RecentLimit caps the session's recently-viewed resources list
+	// (0 uses the built-in default of 20)
+	RecentLimit int `yaml:"recent_limit,omitempty"`
+
+	// ScanWarnThresholdMB asks for confirmation before scanning tables
+	// larger than this (0 uses the built-in 1024 MB default)
+	ScanWarnThresholdMB int `yaml:"scan_warn_threshold_mb,omitempty"`
+
+	// Theme selects the color palette: "auto" (terminal detection, the
+	// default), "dark", or "light". The --theme flag wins when passed
+	Theme string `yaml:"theme,omitempty"`
+
+	// SizeUnits selects how byte sizes render: "binary" (1024 divisor with
+	// legacy KB/MB labels, the default), "iec" (1024 with KiB/MiB labels),
+	// or "si" (1000 with kB/MB labels)
+	SizeUnits string `yaml:"size_units,omitempty"`
+
+	// IdleTimeoutMinutes acts after N minutes without key input
+	// (0 disables the feature, the default)
+	IdleTimeoutMinutes int `yaml:"idle_timeout_minutes,omitempty"`
+
+	// IdleAction is what happens when the idle timeout fires:
+	// "lock" (default), "close-tunnels", or "exit"
+	IdleAction string `yaml:"idle_action,omitempty"`
+
+	// StackBadges annotates stack list rows with lazy per-stack resource
+	// counts (functions/queues/tables). Off by default because it costs one
+	// ListStackResources call per stack.
+	StackBadges bool `yaml:"stack_badges,omitempty"`
+
+	// AutoSelectSingle drills straight into a list's only item when a load
+	// returns exactly one result (e.g. one cluster). Off by default for
+	// users who prefer explicit selection.
+	AutoSelectSingle bool `yaml:"auto_select_single,omitempty"`
+
+	// MouseClicks enables click-to-select and double-click in lists. Off
+	// by default: over SSH, terminal mouse reporting is often noisy
+	MouseClicks bool `yaml:"mouse_clicks,omitempty"`
+
+	// PermissionPreflight enables IAM policy simulation before write actions.
+	// Off by default because iam:SimulatePrincipalPolicy itself requires a
+	// permission; when simulation fails the action is attempted anyway.
+	PermissionPreflight bool `yaml:"permission_preflight,omitempty"`
+}
+
+// LogFetchTimeout returns the configured per-fetch CloudWatch timeout.
+func (c *Config) LogFetchTimeout() time.Duration {
+	if c.Defaults.LogFetchTimeoutSeconds > 0 {
+		return time.Duration(c.Defaults.LogFetchTimeoutSeconds) * time.Second
+	}
+	return DefaultLogFetchTimeout
+}
+
+// ReconnectBackoff returns the configured initial tunnel reconnect delay.
+func (c *Config) ReconnectBackoff() time.Duration {
+	if c.Tunnels.ReconnectBackoffSeconds > 0 {
+		return time.Duration(c.Tunnels.ReconnectBackoffSeconds) * time.Second
+	}
+	return DefaultReconnectBackoff
+}
+
+// ChangeHighlightTTL returns the configured refresh-diff highlight duration.
+func (c *Config) ChangeHighlightTTL() time.Duration {
+	if c.Defaults.ChangeHighlightSeconds > 0 {
+		return time.Duration(c.Defaults.ChangeHighlightSeconds) * time.Second
+	}
+	return DefaultChangeHighlightTTL
 }
 
 var (
@@ -60,6 +266,29 @@ func DefaultConfigPath() string {
 	return filepath.Join(homeDir, ".vaws", "config.yaml")
 }
 
+// Exists reports whether a config file is present at the default path.
+func Exists() bool {
+	_, err := os.Stat(DefaultConfigPath())
+	return err == nil
+}
+
+// DefaultSettings returns the built-in DefaultConfig values used when no
+// config file exists or a section is reset.
+func DefaultSettings() DefaultConfig {
+	return DefaultConfig{
+		JumpHostTags: []string{
+			"vaws:jump-host=true",
+			"Name=bastion",
+			"Name=jump-host",
+		},
+		JumpHostNames: []string{
+			"bastion",
+			"jump-host",
+			"jumphost",
+		},
+	}
+}
+
 // Load loads the configuration from the default path
 func Load() (*Config, error) {
 	return LoadFrom(DefaultConfigPath())
@@ -72,18 +301,7 @@ func LoadFrom(path string) (*Config, error) {
 	// Start with defaults
 	cfg := &Config{
 		Profiles: make(map[string]ProfileConfig),
-		Defaults: DefaultConfig{
-			JumpHostTags: []string{
-				"vaws:jump-host=true",
-				"Name=bastion",
-				"Name=jump-host",
-			},
-			JumpHostNames: []string{
-				"bastion",
-				"jump-host",
-				"jumphost",
-			},
-		},
+		Defaults: DefaultSettings(),
 	}
 
 	// Try to read the config file
@@ -114,18 +332,7 @@ func Get() *Config {
 			// Use defaults on error
 			cfg = &Config{
 				Profiles: make(map[string]ProfileConfig),
-				Defaults: DefaultConfig{
-					JumpHostTags: []string{
-						"vaws:jump-host=true",
-						"Name=bastion",
-						"Name=jump-host",
-					},
-					JumpHostNames: []string{
-						"bastion",
-						"jump-host",
-						"jumphost",
-					},
-				},
+				Defaults: DefaultSettings(),
 			}
 		}
 		globalConfig = cfg
@@ -172,6 +379,121 @@ func (c *Config) GetVPCEndpointID(profile string) string {
 	return ""
 }
 
+// Validate checks the loaded configuration and returns every problem found,
+// each naming the offending key with a suggestion. It runs over the same
+// parsed structure startup uses, so anything it accepts the app accepts.
+func (c *Config) Validate() []error {
+	var errs []error
+	add := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	switch c.Defaults.Theme {
+	case "", "auto", "dark", "light":
+	default:
+		add("defaults.theme: %q is not one of auto, dark, light", c.Defaults.Theme)
+	}
+
+	switch c.Defaults.SizeUnits {
+	case "", "binary", "iec", "si":
+	default:
+		add("defaults.size_units: %q is not one of binary, iec, si", c.Defaults.SizeUnits)
+	}
+
+	switch c.Defaults.IdleAction {
+	case "", "lock", "close-tunnels", "exit":
+	default:
+		add("defaults.idle_action: %q is not one of lock, close-tunnels, exit", c.Defaults.IdleAction)
+	}
+
+	if c.Defaults.IdleTimeoutMinutes < 0 {
+		add("defaults.idle_timeout_minutes: must be >= 0 (0 disables)")
+	}
+	if c.Defaults.ChangeHighlightSeconds < 0 {
+		add("defaults.change_highlight_seconds: must be >= 0 (0 uses the default)")
+	}
+	if c.Defaults.LogFetchTimeoutSeconds < 0 {
+		add("defaults.log_fetch_timeout_seconds: must be >= 0 (0 uses the default)")
+	}
+	if c.Defaults.DescribeCacheTTLSeconds < -1 {
+		add("defaults.describe_cache_ttl_seconds: must be >= -1 (-1 disables, 0 uses the default)")
+	}
+	if c.Tunnels.ReconnectAttempts < 0 {
+		add("tunnels.reconnect_attempts: must be >= 0 (0 disables)")
+	}
+	if c.Tunnels.ReconnectBackoffSeconds < 0 {
+		add("tunnels.reconnect_backoff_seconds: must be >= 0 (0 uses the default)")
+	}
+	if c.Retry.MaxAttempts < 0 {
+		add("retry.max_attempts: must be >= 0 (0 uses the default)")
+	}
+	switch c.Keymap.Preset {
+	case "", "default", "vim":
+	default:
+		add("keymap.preset: %q is not one of default, vim", c.Keymap.Preset)
+	}
+	for action, keys := range c.Keymap.Bindings {
+		if len(keys) == 0 {
+			add("keymap.bindings.%s: needs at least one key", action)
+		}
+	}
+	if c.Retry.BaseDelayMillis < 0 {
+		add("retry.base_delay_ms: must be >= 0 (0 uses the default)")
+	}
+	for i, code := range c.CustomRegions {
+		if !IsValidRegion(code) {
+			add("custom_regions[%d]: %q does not look like an AWS region (e.g. ap-southeast-4)", i, code)
+		}
+	}
+
+	for name, pc := range c.Profiles {
+		if pc.Region != "" && !IsValidRegion(pc.Region) {
+			add("profiles.%s.region: %q does not look like an AWS region (e.g. eu-west-1)", name, pc.Region)
+		}
+		if pc.VPCEndpointID != "" && !IsValidVPCEndpointID(pc.VPCEndpointID) {
+			add("profiles.%s.vpc_endpoint_id: %q does not look like a VPC endpoint id (vpce-...)", name, pc.VPCEndpointID)
+		}
+	}
+
+	for i, tpl := range c.QueryTemplates {
+		if tpl.Name == "" {
+			add("query_templates[%d].name: required", i)
+		}
+		if tpl.Table == "" {
+			add("query_templates[%d].table: required", i)
+		}
+		if tpl.PartitionKeyVal == "" {
+			add("query_templates[%d].partition_value: required (use a :placeholder to prompt)", i)
+		}
+		switch tpl.SortKeyCondition {
+		case "", "=", "<", "<=", ">", ">=", "BETWEEN", "begins_with":
+		default:
+			add("query_templates[%d].sort_condition: %q is not one of =, <, <=, >, >=, BETWEEN, begins_with", i, tpl.SortKeyCondition)
+		}
+	}
+
+	for key := range c.Filters {
+		switch key {
+		case "lambda", "sqs", "dynamodb", "stacks", "ecs":
+		default:
+			add("filters.%s: unknown resource type (use lambda, sqs, dynamodb, stacks, ecs)", key)
+		}
+	}
+
+	for key, secs := range c.AutoRefresh {
+		switch key {
+		case "lambda", "sqs", "dynamodb", "stacks", "ecs":
+		default:
+			add("auto_refresh.%s: unknown resource type (use lambda, sqs, dynamodb, stacks, ecs)", key)
+		}
+		if secs < 0 {
+			add("auto_refresh.%s: must be >= 0 (0 disables)", key)
+		}
+	}
+
+	return errs
+}
+
 // Save saves the configuration to disk
 func (c *Config) Save() error {
 	return c.SaveTo(configPath)
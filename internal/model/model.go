@@ -3,6 +3,7 @@
 package model
 
 import (
+	"encoding/json"
 	"sort"
 	"strings"
 	"time"
@@ -83,8 +84,9 @@ type StackOutput struct {
 
 // StackParameter represents a CloudFormation stack parameter.
 type StackParameter struct {
-	Key   string
-	Value string
+	Key    string
+	Value  string
+	NoEcho bool // Declared NoEcho in the template; value masked in display
 }
 
 // ServiceStatus represents the status of an ECS service.
@@ -116,21 +118,57 @@ type Service struct {
 	LaunchType           string
 	CreatedAt            time.Time
 	Deployments          []Deployment
+	Events               []ServiceEvent // Most recent first, as returned by ECS
 	EnableExecuteCommand bool
 	ContainerPorts       []ContainerPort // Container name -> ports mapping
 }
 
+// ServiceEvent is a single entry from an ECS service's event log.
+type ServiceEvent struct {
+	ID        string
+	Message   string
+	CreatedAt time.Time
+}
+
+// DiagnoseServiceEvents scans recent service events for known ECS placement
+// and capacity failure patterns and returns a short actionable diagnosis,
+// or "" when nothing recognizable is found.
+func DiagnoseServiceEvents(events []ServiceEvent) string {
+	for _, e := range events {
+		msg := strings.ToLower(e.Message)
+		switch {
+		case strings.Contains(msg, "unable to place a task") && strings.Contains(msg, "no container instance met"):
+			return "No EC2 capacity in cluster - scale the ASG or check the capacity provider"
+		case strings.Contains(msg, "insufficient memory"):
+			return "Container instances lack free memory - use smaller tasks or add capacity"
+		case strings.Contains(msg, "insufficient cpu"):
+			return "Container instances lack free CPU - use smaller tasks or add capacity"
+		case strings.Contains(msg, "capacity provider") && strings.Contains(msg, "unable"):
+			return "Capacity provider could not provide capacity - check its auto scaling group"
+		case strings.Contains(msg, "deregistered") && strings.Contains(msg, "target"):
+			return "Tasks are failing load balancer health checks - check the target group and app health"
+		case strings.Contains(msg, "unable to consistently start tasks"):
+			return "Service is crash-looping - check container logs and task stopped reasons"
+		}
+	}
+	return ""
+}
+
 // Task represents an ECS task.
 type Task struct {
-	TaskARN           string
-	TaskID            string
-	ClusterARN        string
-	TaskDefinitionARN string
-	LastStatus        string
-	DesiredStatus     string
-	LaunchType        string
-	Containers        []Container
-	StartedAt         time.Time
+	TaskARN              string
+	TaskID               string
+	ClusterARN           string
+	TaskDefinitionARN    string
+	ContainerInstanceARN string // Set for EC2-launch tasks
+	PlatformVersion      string // Set for Fargate tasks
+	LastStatus           string
+	DesiredStatus        string
+	HealthStatus         string // HEALTHY, UNHEALTHY, UNKNOWN
+	AvailabilityZone     string
+	LaunchType           string
+	Containers           []Container
+	StartedAt            time.Time
 }
 
 // Container represents a container in an ECS task.
@@ -256,19 +294,27 @@ type Tunnel struct {
 	ClusterName   string
 	TaskID        string
 	ContainerName string
+	SSMTarget     string // ecs:<cluster>_<task>_<runtime> target the session runs against
 	Status        TunnelStatus
 	StartedAt     time.Time
 	Error         string
+
+	// Traffic statistics from the local relay. Zero for tunnels without a
+	// relay (re-adopted from a version that listened directly).
+	BytesIn     int64 // remote -> client
+	BytesOut    int64 // client -> remote
+	ActiveConns int
 }
 
 // TunnelStatus represents the status of a tunnel.
 type TunnelStatus string
 
 const (
-	TunnelStatusStarting   TunnelStatus = "STARTING"
-	TunnelStatusActive     TunnelStatus = "ACTIVE"
-	TunnelStatusError      TunnelStatus = "ERROR"
-	TunnelStatusTerminated TunnelStatus = "TERMINATED"
+	TunnelStatusStarting     TunnelStatus = "STARTING"
+	TunnelStatusActive       TunnelStatus = "ACTIVE"
+	TunnelStatusReconnecting TunnelStatus = "RECONNECTING"
+	TunnelStatusError        TunnelStatus = "ERROR"
+	TunnelStatusTerminated   TunnelStatus = "TERMINATED"
 )
 
 // IsHealthy returns true if the service has all desired tasks running.
@@ -279,7 +325,8 @@ func (s *Service) IsHealthy() bool {
 // Deployment represents an ECS service deployment.
 type Deployment struct {
 	ID             string
-	Status         string
+	Status         string // PRIMARY or ACTIVE
+	RolloutState   string // IN_PROGRESS, COMPLETED, FAILED
 	DesiredCount   int
 	RunningCount   int
 	PendingCount   int
@@ -288,6 +335,49 @@ type Deployment struct {
 	UpdatedAt      time.Time
 }
 
+// PrimaryDeployment returns the service's PRIMARY deployment, if any.
+func (s *Service) PrimaryDeployment() *Deployment {
+	for i := range s.Deployments {
+		if s.Deployments[i].Status == "PRIMARY" {
+			return &s.Deployments[i]
+		}
+	}
+	return nil
+}
+
+// IsStable reports whether the service has settled: all desired tasks
+// running, none pending, and the primary rollout (if tracked) completed.
+func (s *Service) IsStable() bool {
+	if s.RunningCount != s.DesiredCount || s.PendingCount != 0 {
+		return false
+	}
+	if d := s.PrimaryDeployment(); d != nil && d.RolloutState != "" && d.RolloutState != "COMPLETED" {
+		return false
+	}
+	return true
+}
+
+// TaskDefinition captures the parts of an ECS task definition worth
+// confirming before a deploy: images, sizing, ports, and environment.
+type TaskDefinition struct {
+	ARN        string
+	Family     string
+	Revision   string
+	CPU        string
+	Memory     string
+	Containers []TaskDefContainer
+}
+
+// TaskDefContainer is one container definition within a task definition.
+type TaskDefContainer struct {
+	Name        string
+	Image       string
+	CPU         int
+	Memory      int
+	Ports       []int
+	Environment map[string]string
+}
+
 // Cluster represents an ECS cluster.
 type Cluster struct {
 	Name                              string
@@ -334,7 +424,107 @@ type Function struct {
 	Description  string
 	State        FunctionState
 	Role         string
-	PackageType  string // Zip or Image
+	PackageType  string            // Zip or Image
+	Tags         map[string]string // Resource tags (populated by DescribeFunction)
+	Environment  map[string]string // Environment variables
+	VpcConfig    VPCConfig
+}
+
+// VPCConfig describes a Lambda function's VPC attachment.
+type VPCConfig struct {
+	VpcID            string
+	SubnetIDs        []string
+	SecurityGroupIDs []string
+}
+
+// InVPC reports whether the function is attached to a VPC.
+func (f *Function) InVPC() bool {
+	return f.VpcConfig.VpcID != ""
+}
+
+// StackEvent is one entry from a stack's event history.
+type StackEvent struct {
+	Timestamp time.Time
+	LogicalID string
+	Type      string
+	Status    string
+	Reason    string
+}
+
+// StackResource is one resource in a CloudFormation stack.
+type StackResource struct {
+	LogicalID  string
+	PhysicalID string
+	Type       string
+	Status     string
+}
+
+// FunctionUrl describes a Lambda function URL configuration.
+type FunctionUrl struct {
+	URL          string
+	AuthType     string // NONE or AWS_IAM
+	AllowOrigins []string
+	AllowMethods []string
+}
+
+// StackName returns the CloudFormation stack that created this function,
+// derived from the aws:cloudformation:stack-name tag. Empty if untagged.
+func (f *Function) StackName() string {
+	return f.Tags["aws:cloudformation:stack-name"]
+}
+
+// NamePrefix returns the function name up to the first occurrence of delim,
+// or the full name if it contains no delimiter.
+func (f *Function) NamePrefix(delim string) string {
+	if i := strings.Index(f.Name, delim); i > 0 {
+		return f.Name[:i]
+	}
+	return f.Name
+}
+
+// EventSourceMapping represents a Lambda event source mapping (SQS queue,
+// Kinesis/DynamoDB stream, etc. triggering the function).
+type EventSourceMapping struct {
+	UUID                     string
+	EventSourceARN           string
+	State                    string // Enabled, Disabled, Creating, ...
+	BatchSize                int
+	MaxBatchingWindowSeconds int
+}
+
+// SourceName returns the final ARN segment, e.g. the queue or stream name.
+func (e *EventSourceMapping) SourceName() string {
+	if i := strings.LastIndex(e.EventSourceARN, ":"); i >= 0 {
+		name := e.EventSourceARN[i+1:]
+		if j := strings.LastIndex(name, "/"); j >= 0 {
+			name = name[j+1:]
+		}
+		return name
+	}
+	return e.EventSourceARN
+}
+
+// IsSQS reports whether the mapping's source is an SQS queue.
+func (e *EventSourceMapping) IsSQS() bool {
+	return strings.HasPrefix(e.EventSourceARN, "arn:aws:sqs:")
+}
+
+// LambdaAlias represents a Lambda alias and its routing configuration.
+type LambdaAlias struct {
+	Name            string
+	FunctionVersion string
+	Description     string
+	// RoutingWeights maps additional versions to their traffic share for
+	// weighted (canary) aliases
+	RoutingWeights map[string]float64
+}
+
+// LambdaVersion represents a published Lambda function version.
+type LambdaVersion struct {
+	Version      string
+	Description  string
+	CodeSize     int64
+	LastModified time.Time
 }
 
 // InvocationResult represents the result of a Lambda function invocation.
@@ -347,6 +537,8 @@ type InvocationResult struct {
 	LogResult       string        // Base64 encoded last 4KB of execution log
 	Duration        time.Duration // Client-side measured duration
 	InvokedAt       time.Time
+	ResponsePath    string // File the response payload was written to (file payloads)
+	LogsPath        string // File the decoded execution log was written to
 }
 
 // RestAPI represents an API Gateway REST API (v1).
@@ -379,6 +571,7 @@ type APIStage struct {
 	CreatedDate  time.Time
 	LastUpdated  time.Time
 	InvokeURL    string
+	Variables    map[string]string // Stage variables driving integrations
 }
 
 // APIRoute represents a route in API Gateway HTTP API.
@@ -440,6 +633,15 @@ type APIGatewayTunnel struct {
 	Error       string
 }
 
+// InsightsField is one column/value pair of a Logs Insights result row.
+type InsightsField struct {
+	Field string
+	Value string
+}
+
+// InsightsRow is one result row of a Logs Insights query.
+type InsightsRow []InsightsField
+
 // CloudWatchLogEntry represents a single CloudWatch log event.
 type CloudWatchLogEntry struct {
 	Timestamp     time.Time
@@ -448,6 +650,23 @@ type CloudWatchLogEntry struct {
 	LogStreamName string
 }
 
+// MetricPoint is one datapoint of a CloudWatch metric series.
+type MetricPoint struct {
+	Timestamp time.Time
+	Value     float64
+}
+
+// Alarm summarizes a CloudWatch alarm configured on a metric.
+type Alarm struct {
+	Name               string
+	State              string // OK, ALARM, INSUFFICIENT_DATA
+	StateReason        string
+	MetricName         string
+	Namespace          string
+	Threshold          float64
+	ComparisonOperator string
+}
+
 // ContainerLogConfig holds CloudWatch log configuration for a container.
 type ContainerLogConfig struct {
 	ContainerName   string
@@ -477,6 +696,7 @@ type Queue struct {
 	MessageRetentionPeriod  int // In seconds
 	DelaySeconds            int
 	CreatedAt               time.Time
+	Policy                  string // Raw access (resource) policy JSON, if set
 	// DLQ info
 	HasDLQ          bool
 	DLQArn          string
@@ -491,6 +711,122 @@ func (q *Queue) HasDLQMessages() bool {
 	return q.HasDLQ && q.DLQMessageCount > 0
 }
 
+// Message represents a message read from an SQS queue.
+type Message struct {
+	MessageID               string
+	Body                    string
+	ReceiptHandle           string
+	Attributes              map[string]string // System attributes (SentTimestamp, MessageGroupId, ...)
+	MessageAttributes       map[string]string // Custom attributes, flattened to "value (DataType)"
+	ApproximateReceiveCount int
+}
+
+// PrettyBody returns the body re-indented as JSON when it parses as JSON,
+// or the raw body otherwise.
+func (m *Message) PrettyBody() string {
+	var out interface{}
+	if err := json.Unmarshal([]byte(m.Body), &out); err != nil {
+		return m.Body
+	}
+	pretty, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return m.Body
+	}
+	return string(pretty)
+}
+
+// PolicyStatement is a simplified view of one access policy statement.
+type PolicyStatement struct {
+	Effect       string
+	Principals   []string
+	Actions      []string
+	HasCondition bool
+}
+
+// IsOverlyBroad reports whether the statement allows Principal "*" without
+// any condition, i.e. the queue is open to anyone.
+func (s PolicyStatement) IsOverlyBroad() bool {
+	if s.Effect != "Allow" || s.HasCondition {
+		return false
+	}
+	for _, p := range s.Principals {
+		if p == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseQueuePolicy extracts a simplified statement summary from a queue's
+// access policy JSON. Returns nil when the policy is absent or unparsable.
+func ParseQueuePolicy(policy string) []PolicyStatement {
+	var doc struct {
+		Statement json.RawMessage `json:"Statement"`
+	}
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil || len(doc.Statement) == 0 {
+		return nil
+	}
+
+	var raws []json.RawMessage
+	if err := json.Unmarshal(doc.Statement, &raws); err != nil {
+		raws = []json.RawMessage{doc.Statement} // single statement object
+	}
+
+	var statements []PolicyStatement
+	for _, raw := range raws {
+		var st struct {
+			Effect    string
+			Principal interface{}
+			Action    interface{}
+			Condition map[string]interface{}
+		}
+		if err := json.Unmarshal(raw, &st); err != nil {
+			continue
+		}
+		statements = append(statements, PolicyStatement{
+			Effect:       st.Effect,
+			Principals:   flattenPolicyValues(st.Principal),
+			Actions:      flattenPolicyValues(st.Action),
+			HasCondition: len(st.Condition) > 0,
+		})
+	}
+	return statements
+}
+
+// flattenPolicyValues normalizes a policy principal/action field, which may
+// be a string, a list, or a map of lists ({"AWS": [...]}), into a flat,
+// sorted string slice.
+func flattenPolicyValues(v interface{}) []string {
+	var out []string
+	switch val := v.(type) {
+	case string:
+		return []string{val}
+	case []interface{}:
+		for _, item := range val {
+			out = append(out, flattenPolicyValues(item)...)
+		}
+	case map[string]interface{}:
+		for _, item := range val {
+			out = append(out, flattenPolicyValues(item)...)
+		}
+		sort.Strings(out)
+	}
+	return out
+}
+
+// MessageMoveTask represents an SQS DLQ redrive (message move) task.
+type MessageMoveTask struct {
+	TaskHandle     string
+	Status         string // RUNNING, COMPLETED, CANCELLING, CANCELLED, FAILED
+	SourceARN      string
+	DestinationARN string
+	MovedCount     int64
+	TotalCount     int64
+	MaxPerSecond   int
+	FailureReason  string
+	StartedAt      time.Time
+}
+
 // TableStatus represents the status of a DynamoDB table.
 type TableStatus string
 
@@ -537,6 +873,7 @@ type KeySchemaElement struct {
 type GlobalSecondaryIndex struct {
 	IndexName  string
 	KeySchema  []KeySchemaElement
+	Projection string // ALL, KEYS_ONLY, INCLUDE
 	Status     string
 	ItemCount  int64
 	SizeBytes  int64
@@ -546,10 +883,34 @@ type GlobalSecondaryIndex struct {
 type LocalSecondaryIndex struct {
 	IndexName  string
 	KeySchema  []KeySchemaElement
+	Projection string // ALL, KEYS_ONLY, INCLUDE
 	ItemCount  int64
 	SizeBytes  int64
 }
 
+// keyNames extracts the HASH and RANGE attribute names from a key schema.
+func keyNames(schema []KeySchemaElement) (pk, sk string) {
+	for _, k := range schema {
+		switch k.KeyType {
+		case "HASH":
+			pk = k.AttributeName
+		case "RANGE":
+			sk = k.AttributeName
+		}
+	}
+	return pk, sk
+}
+
+// Keys returns the index's partition and sort key attribute names.
+func (g *GlobalSecondaryIndex) Keys() (pk, sk string) {
+	return keyNames(g.KeySchema)
+}
+
+// Keys returns the index's partition and sort key attribute names.
+func (l *LocalSecondaryIndex) Keys() (pk, sk string) {
+	return keyNames(l.KeySchema)
+}
+
 // Table represents a DynamoDB table.
 type Table struct {
 	Name                   string
@@ -645,6 +1006,9 @@ type DynamoDBItem struct {
 	Raw map[string]interface{}
 	// JSON is the formatted JSON string of the item
 	JSON string
+	// AttributeJSON is the item in DynamoDB AttributeValue wire format,
+	// suitable for `aws dynamodb put-item --item`
+	AttributeJSON string
 	// PartitionKeyValue is the PK value for quick display
 	PartitionKeyValue string
 	// SortKeyValue is the SK value for quick display (may be empty)
@@ -665,6 +1029,7 @@ type QueryResult struct {
 	Count             int
 	ScannedCount      int
 	LastEvaluatedKey  map[string]interface{}
+	NextToken         string // PartiQL pagination token
 	ConsumedCapacity  float64
 	HasMorePages      bool
 }
@@ -0,0 +1,159 @@
+package state
+
+import (
+	"time"
+
+	"vaws/internal/model"
+)
+
+// ChangeKind classifies a row change between two refreshes of a list.
+type ChangeKind int
+
+const (
+	ChangeAdded ChangeKind = iota
+	ChangeRemoved
+	ChangeUpdated
+)
+
+// RowChange records an observed change so list views can briefly highlight
+// what a refresh changed. Expiry is decided at render time so the highlight
+// duration stays configurable without the state package knowing about it.
+type RowChange struct {
+	Kind  ChangeKind
+	Delta int       // numeric delta for updated rows (sign carries direction)
+	At    time.Time // when the change was observed
+}
+
+// Expired reports whether the change highlight has outlived ttl.
+func (r RowChange) Expired(ttl time.Duration) bool {
+	return time.Since(r.At) > ttl
+}
+
+// maxChangeAge bounds how long change entries are carried between refreshes
+// before being pruned, regardless of the configured highlight duration.
+const maxChangeAge = time.Minute
+
+// DiffStacks records row changes between the current and incoming stack
+// slices. Call before replacing s.Stacks with the new slice.
+func (s *State) DiffStacks(newStacks []model.Stack) {
+	if s.Stacks == nil {
+		return // first load, nothing to diff against
+	}
+
+	now := time.Now()
+	changes := make(map[string]RowChange)
+
+	prev := make(map[string]model.Stack, len(s.Stacks))
+	for _, st := range s.Stacks {
+		prev[st.Name] = st
+	}
+
+	seen := make(map[string]bool, len(newStacks))
+	for _, st := range newStacks {
+		seen[st.Name] = true
+		old, ok := prev[st.Name]
+		if !ok {
+			changes[st.Name] = RowChange{Kind: ChangeAdded, At: now}
+			continue
+		}
+		if old.Status != st.Status {
+			changes[st.Name] = RowChange{Kind: ChangeUpdated, At: now}
+		}
+	}
+
+	for name := range prev {
+		if !seen[name] {
+			changes[name] = RowChange{Kind: ChangeRemoved, At: now}
+		}
+	}
+
+	carryChanges(s.StackChanges, changes)
+	s.StackChanges = changes
+}
+
+// DiffServices records row changes between the current and incoming service
+// slices. Running-count changes carry the delta for arrow display.
+func (s *State) DiffServices(newServices []model.Service) {
+	if s.Services == nil {
+		return
+	}
+
+	now := time.Now()
+	changes := make(map[string]RowChange)
+
+	prev := make(map[string]model.Service, len(s.Services))
+	for _, svc := range s.Services {
+		prev[svc.Name] = svc
+	}
+
+	seen := make(map[string]bool, len(newServices))
+	for _, svc := range newServices {
+		seen[svc.Name] = true
+		old, ok := prev[svc.Name]
+		if !ok {
+			changes[svc.Name] = RowChange{Kind: ChangeAdded, At: now}
+			continue
+		}
+		if old.RunningCount != svc.RunningCount {
+			changes[svc.Name] = RowChange{
+				Kind:  ChangeUpdated,
+				Delta: svc.RunningCount - old.RunningCount,
+				At:    now,
+			}
+		}
+	}
+
+	for name := range prev {
+		if !seen[name] {
+			changes[name] = RowChange{Kind: ChangeRemoved, At: now}
+		}
+	}
+
+	carryChanges(s.ServiceChanges, changes)
+	s.ServiceChanges = changes
+}
+
+// DiffQueues records message-count changes between the current and incoming
+// queue slices. Removal is not tracked because queues load incrementally and
+// a partial page would misreport every not-yet-loaded queue as removed.
+func (s *State) DiffQueues(newQueues []model.Queue) {
+	if s.Queues == nil {
+		return
+	}
+
+	now := time.Now()
+	changes := make(map[string]RowChange)
+
+	prev := make(map[string]model.Queue, len(s.Queues))
+	for _, q := range s.Queues {
+		prev[q.Name] = q
+	}
+
+	for _, q := range newQueues {
+		old, ok := prev[q.Name]
+		if !ok {
+			changes[q.Name] = RowChange{Kind: ChangeAdded, At: now}
+			continue
+		}
+		if old.ApproximateMessageCount != q.ApproximateMessageCount {
+			changes[q.Name] = RowChange{
+				Kind:  ChangeUpdated,
+				Delta: q.ApproximateMessageCount - old.ApproximateMessageCount,
+				At:    now,
+			}
+		}
+	}
+
+	carryChanges(s.QueueChanges, changes)
+	s.QueueChanges = changes
+}
+
+// carryChanges copies still-fresh entries from the previous change map into
+// the new one unless the new refresh superseded them.
+func carryChanges(old, changes map[string]RowChange) {
+	for name, ch := range old {
+		if _, ok := changes[name]; !ok && !ch.Expired(maxChangeAge) {
+			changes[name] = ch
+		}
+	}
+}
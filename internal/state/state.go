@@ -2,6 +2,9 @@
 package state
 
 import (
+	"path"
+	"strings"
+
 	"vaws/internal/model"
 )
 
@@ -30,6 +33,29 @@ const (
 	ViewDynamoDB        // DynamoDB tables view
 	ViewDynamoDBQuery   // DynamoDB query results view
 	ViewRegionSelect    // Region selection view
+	ViewGlobalSearch    // Cross-resource search results view
+	ViewQueryTemplates  // Saved DynamoDB query template picker
+	ViewSQSMessages     // Peeked SQS message inspection view
+	ViewLambdaAliases   // Lambda aliases and versions sub-view
+	ViewStackTree       // Full stack resource listing grouped by type
+)
+
+// LambdaGrouping controls how the Lambda functions list is grouped.
+type LambdaGrouping int
+
+const (
+	LambdaGroupNone   LambdaGrouping = iota
+	LambdaGroupStack                 // Group by aws:cloudformation:stack-name tag
+	LambdaGroupPrefix                // Group by name prefix (split on "-")
+)
+
+// LambdaVPCFilter restricts the Lambda list by VPC attachment.
+type LambdaVPCFilter int
+
+const (
+	LambdaVPCAll  LambdaVPCFilter = iota
+	LambdaVPCOnly                 // Only VPC-attached functions
+	LambdaVPCNone                 // Only functions outside a VPC
 )
 
 // State holds all application state.
@@ -76,6 +102,11 @@ type State struct {
 	FunctionsError   error
 	SelectedFunction *model.Function
 
+	// Lambda list grouping (persisted for the lifetime of the session)
+	LambdaGrouping        LambdaGrouping
+	LambdaCollapsedGroups map[string]bool
+	LambdaVPCFilter       LambdaVPCFilter
+
 	// Lambda invocation state
 	LambdaInvocationResult  *model.InvocationResult
 	LambdaInvocationLoading bool
@@ -129,6 +160,13 @@ type State struct {
 	QueuesError   error
 	SelectedQueue *model.Queue
 
+	// Local per-queue send tracking for approximate-count lag feedback
+	QueueSends        map[string]int // messages sent this session
+	QueueSendBaseline map[string]int // approximate count observed at first send
+
+	// Peeked messages for the inspection view
+	PeekedMessages []model.Message
+
 	// DynamoDB Tables state
 	Tables        []model.Table
 	TablesLoading bool
@@ -144,6 +182,16 @@ type State struct {
 	DynamoDBLastKey      map[string]interface{} // For pagination
 	DynamoDBIsQuery      bool                   // true = query, false = scan
 
+	// Refresh diff highlights (row key -> observed change)
+	StackChanges   map[string]RowChange
+	ServiceChanges map[string]RowChange
+	QueueChanges   map[string]RowChange
+
+	// Default include/exclude rules from config, applied per view key
+	DefaultFilters    map[string]DefaultFilterRules
+	DefaultFiltersOff bool           // temporarily disabled to see everything
+	DefaultHidden     map[string]int // view key -> rows hidden on last pass
+
 	// UI state
 	ShowLogs      bool
 	FilterText    string
@@ -236,12 +284,25 @@ func (s *State) SetRegion(region string) {
 	s.ClearStacks()
 }
 
+// ClearAllData clears every cached resource slice, e.g. after switching
+// profile or region.
+func (s *State) ClearAllData() {
+	s.ClearStacks()
+	s.ClearQueues()
+	s.ClearTables()
+	s.ClearFunctions()
+	s.ClearAPIs()
+	s.Clusters = nil
+	s.ClustersError = nil
+}
+
 // ClearStacks clears all stack and service data.
 func (s *State) ClearStacks() {
 	s.Stacks = nil
 	s.StacksLoading = false
 	s.StacksError = nil
 	s.SelectedStack = nil
+	s.StackChanges = nil
 	s.ClearServices()
 }
 
@@ -251,6 +312,7 @@ func (s *State) ClearServices() {
 	s.ServicesLoading = false
 	s.ServicesError = nil
 	s.SelectedService = nil
+	s.ServiceChanges = nil
 }
 
 // ClearFunctions clears Lambda function data.
@@ -261,6 +323,62 @@ func (s *State) ClearFunctions() {
 	s.SelectedFunction = nil
 }
 
+// CycleLambdaGrouping advances the Lambda list grouping mode.
+func (s *State) CycleLambdaGrouping() {
+	switch s.LambdaGrouping {
+	case LambdaGroupNone:
+		s.LambdaGrouping = LambdaGroupStack
+	case LambdaGroupStack:
+		s.LambdaGrouping = LambdaGroupPrefix
+	default:
+		s.LambdaGrouping = LambdaGroupNone
+	}
+}
+
+// LambdaGroupingName returns a human-readable name for the grouping mode.
+func (s *State) LambdaGroupingName() string {
+	switch s.LambdaGrouping {
+	case LambdaGroupStack:
+		return "stack"
+	case LambdaGroupPrefix:
+		return "prefix"
+	default:
+		return "none"
+	}
+}
+
+// CycleLambdaVPCFilter advances the VPC attachment filter.
+func (s *State) CycleLambdaVPCFilter() {
+	switch s.LambdaVPCFilter {
+	case LambdaVPCAll:
+		s.LambdaVPCFilter = LambdaVPCOnly
+	case LambdaVPCOnly:
+		s.LambdaVPCFilter = LambdaVPCNone
+	default:
+		s.LambdaVPCFilter = LambdaVPCAll
+	}
+}
+
+// LambdaVPCFilterName returns a human-readable name for the VPC filter.
+func (s *State) LambdaVPCFilterName() string {
+	switch s.LambdaVPCFilter {
+	case LambdaVPCOnly:
+		return "vpc-attached only"
+	case LambdaVPCNone:
+		return "non-vpc only"
+	default:
+		return "all"
+	}
+}
+
+// ToggleLambdaGroup toggles the collapsed state of a Lambda list group.
+func (s *State) ToggleLambdaGroup(name string) {
+	if s.LambdaCollapsedGroups == nil {
+		s.LambdaCollapsedGroups = make(map[string]bool)
+	}
+	s.LambdaCollapsedGroups[name] = !s.LambdaCollapsedGroups[name]
+}
+
 // ClearLambdaInvocation clears Lambda invocation state.
 func (s *State) ClearLambdaInvocation() {
 	s.LambdaInvocationResult = nil
@@ -336,6 +454,10 @@ func (s *State) ClearQueues() {
 	s.QueuesLoading = false
 	s.QueuesError = nil
 	s.SelectedQueue = nil
+	s.QueueChanges = nil
+	s.QueueSends = nil
+	s.QueueSendBaseline = nil
+	s.PeekedMessages = nil
 }
 
 // SelectQueue sets the selected SQS queue.
@@ -343,6 +465,20 @@ func (s *State) SelectQueue(queue *model.Queue) {
 	s.SelectedQueue = queue
 }
 
+// RecordQueueSend notes a message sent to a queue this session, remembering
+// the approximate count at first send so views can tell when the
+// eventually-consistent metric is lagging behind.
+func (s *State) RecordQueueSend(q *model.Queue) {
+	if s.QueueSends == nil {
+		s.QueueSends = make(map[string]int)
+		s.QueueSendBaseline = make(map[string]int)
+	}
+	if s.QueueSends[q.Name] == 0 {
+		s.QueueSendBaseline[q.Name] = q.ApproximateMessageCount
+	}
+	s.QueueSends[q.Name]++
+}
+
 // ClearTables clears DynamoDB table data.
 func (s *State) ClearTables() {
 	s.Tables = nil
@@ -431,48 +567,70 @@ func (s *State) ToggleLogs() {
 	s.ShowLogs = !s.ShowLogs
 }
 
-// FilteredStacks returns stacks filtered by the current filter text.
+// FilteredStacks returns stacks filtered by the default rules and the
+// current filter text.
 func (s *State) FilteredStacks() []model.Stack {
-	if s.FilterText == "" {
-		return s.Stacks
-	}
-
+	hidden := 0
 	var filtered []model.Stack
 	for _, stack := range s.Stacks {
-		if containsIgnoreCase(stack.Name, s.FilterText) {
-			filtered = append(filtered, stack)
+		if !s.matchesDefaultRules("stacks", stack.Name) {
+			hidden++
+			continue
+		}
+		if s.FilterText != "" && !containsIgnoreCase(stack.Name, s.FilterText) {
+			continue
 		}
+		filtered = append(filtered, stack)
 	}
+	s.recordHidden("stacks", hidden)
 	return filtered
 }
 
-// FilteredServices returns services filtered by the current filter text.
+// FilteredServices returns services filtered by the default rules and the
+// current filter text.
 func (s *State) FilteredServices() []model.Service {
-	if s.FilterText == "" {
-		return s.Services
-	}
-
+	hidden := 0
 	var filtered []model.Service
 	for _, svc := range s.Services {
-		if containsIgnoreCase(svc.Name, s.FilterText) {
-			filtered = append(filtered, svc)
+		if !s.matchesDefaultRules("ecs", svc.Name) {
+			hidden++
+			continue
+		}
+		if s.FilterText != "" && !containsIgnoreCase(svc.Name, s.FilterText) {
+			continue
 		}
+		filtered = append(filtered, svc)
 	}
+	s.recordHidden("ecs", hidden)
 	return filtered
 }
 
-// FilteredFunctions returns Lambda functions filtered by the current filter text.
+// FilteredFunctions returns Lambda functions filtered by the default rules
+// and the current filter text.
 func (s *State) FilteredFunctions() []model.Function {
-	if s.FilterText == "" {
-		return s.Functions
-	}
-
+	hidden := 0
 	var filtered []model.Function
 	for _, fn := range s.Functions {
-		if containsIgnoreCase(fn.Name, s.FilterText) {
-			filtered = append(filtered, fn)
+		if !s.matchesDefaultRules("lambda", fn.Name) {
+			hidden++
+			continue
+		}
+		switch s.LambdaVPCFilter {
+		case LambdaVPCOnly:
+			if !fn.InVPC() {
+				continue
+			}
+		case LambdaVPCNone:
+			if fn.InVPC() {
+				continue
+			}
+		}
+		if s.FilterText != "" && !containsIgnoreCase(fn.Name, s.FilterText) {
+			continue
 		}
+		filtered = append(filtered, fn)
 	}
+	s.recordHidden("lambda", hidden)
 	return filtered
 }
 
@@ -544,13 +702,33 @@ func (s *State) FilteredEC2Instances() []model.EC2Instance {
 
 	var filtered []model.EC2Instance
 	for _, inst := range s.EC2Instances {
-		if containsIgnoreCase(inst.Name, s.FilterText) || containsIgnoreCase(inst.InstanceID, s.FilterText) {
+		if containsIgnoreCase(inst.Name, s.FilterText) || containsIgnoreCase(inst.InstanceID, s.FilterText) ||
+			matchesTagFilter(inst.Tags, s.FilterText) {
 			filtered = append(filtered, inst)
 		}
 	}
 	return filtered
 }
 
+// matchesTagFilter matches filter text against instance tags, supporting
+// key=value as well as plain substring matches on keys and values.
+func matchesTagFilter(tags map[string]string, filter string) bool {
+	if key, value, ok := strings.Cut(filter, "="); ok {
+		for k, v := range tags {
+			if containsIgnoreCase(k, key) && containsIgnoreCase(v, value) {
+				return true
+			}
+		}
+		return false
+	}
+	for k, v := range tags {
+		if containsIgnoreCase(k, filter) || containsIgnoreCase(v, filter) {
+			return true
+		}
+	}
+	return false
+}
+
 // FilteredContainers returns containers filtered by the current filter text.
 func (s *State) FilteredContainers() []model.Container {
 	if s.FilterText == "" {
@@ -566,36 +744,108 @@ func (s *State) FilteredContainers() []model.Container {
 	return filtered
 }
 
-// FilteredQueues returns SQS queues filtered by the current filter text.
+// FilteredQueues returns SQS queues filtered by the default rules and the
+// current filter text.
 func (s *State) FilteredQueues() []model.Queue {
-	if s.FilterText == "" {
-		return s.Queues
-	}
-
+	hidden := 0
 	var filtered []model.Queue
 	for _, q := range s.Queues {
-		if containsIgnoreCase(q.Name, s.FilterText) {
-			filtered = append(filtered, q)
+		if !s.matchesDefaultRules("sqs", q.Name) {
+			hidden++
+			continue
+		}
+		if s.FilterText != "" && !containsIgnoreCase(q.Name, s.FilterText) {
+			continue
 		}
+		filtered = append(filtered, q)
 	}
+	s.recordHidden("sqs", hidden)
 	return filtered
 }
 
-// FilteredTables returns DynamoDB tables filtered by the current filter text.
+// FilteredTables returns DynamoDB tables filtered by the default rules and
+// the current filter text.
 func (s *State) FilteredTables() []model.Table {
-	if s.FilterText == "" {
-		return s.Tables
-	}
-
+	hidden := 0
 	var filtered []model.Table
 	for _, t := range s.Tables {
-		if containsIgnoreCase(t.Name, s.FilterText) {
-			filtered = append(filtered, t)
+		if !s.matchesDefaultRules("dynamodb", t.Name) {
+			hidden++
+			continue
+		}
+		if s.FilterText != "" && !containsIgnoreCase(t.Name, s.FilterText) {
+			continue
 		}
+		filtered = append(filtered, t)
 	}
+	s.recordHidden("dynamodb", hidden)
 	return filtered
 }
 
+// DefaultFilterRules holds default include/exclude name patterns for a view.
+type DefaultFilterRules struct {
+	Include []string
+	Exclude []string
+}
+
+// SetDefaultFilter installs default include/exclude patterns for a view key.
+func (s *State) SetDefaultFilter(key string, include, exclude []string) {
+	if s.DefaultFilters == nil {
+		s.DefaultFilters = make(map[string]DefaultFilterRules)
+	}
+	s.DefaultFilters[key] = DefaultFilterRules{Include: include, Exclude: exclude}
+}
+
+// matchesDefaultRules reports whether a name passes the default rules for a
+// view key. Everything passes when rules are absent or temporarily disabled.
+func (s *State) matchesDefaultRules(key, name string) bool {
+	if s.DefaultFiltersOff {
+		return true
+	}
+	rules, ok := s.DefaultFilters[key]
+	if !ok {
+		return true
+	}
+
+	if len(rules.Include) > 0 {
+		included := false
+		for _, p := range rules.Include {
+			if matchesPattern(name, p) {
+				included = true
+				break
+			}
+		}
+		if !included {
+			return false
+		}
+	}
+
+	for _, p := range rules.Exclude {
+		if matchesPattern(name, p) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesPattern matches name against pattern: glob syntax when the pattern
+// contains metacharacters, case-insensitive substring otherwise.
+func matchesPattern(name, pattern string) bool {
+	if strings.ContainsAny(pattern, "*?[") {
+		ok, err := path.Match(pattern, name)
+		return err == nil && ok
+	}
+	return containsIgnoreCase(name, pattern)
+}
+
+// recordHidden notes how many rows the default rules hid for a view key.
+func (s *State) recordHidden(key string, n int) {
+	if s.DefaultHidden == nil {
+		s.DefaultHidden = make(map[string]int)
+	}
+	s.DefaultHidden[key] = n
+}
+
 func containsIgnoreCase(s, substr string) bool {
 	return len(s) >= len(substr) && (substr == "" ||
 		findIgnoreCase(s, substr) >= 0)
@@ -45,6 +45,29 @@ func NewAPIGatewayManager(profile, region string) *APIGatewayManager {
 	}
 }
 
+// ExportSSHConfig renders the active jump-host-based API Gateway tunnels as
+// SSH config Host blocks with LocalForward entries, for users who want the
+// forwards outside vaws. Returns "" when no private tunnels are active.
+func (m *APIGatewayManager) ExportSSHConfig() string {
+	var b strings.Builder
+	for _, t := range m.GetActiveTunnels() {
+		if t.TunnelType != model.APIGatewayTunnelPrivate || t.JumpHost == nil {
+			continue
+		}
+
+		remoteHost := fmt.Sprintf("%s.execute-api.%s.amazonaws.com", t.APIID, m.region)
+		if t.VpcEndpoint != nil && len(t.VpcEndpoint.DNSEntries) > 0 {
+			remoteHost = t.VpcEndpoint.DNSEntries[0]
+		}
+
+		b.WriteString(fmt.Sprintf("# API Gateway %s (%s) via jump host %s\n", t.APIName, t.StageName, t.JumpHost.Name))
+		b.WriteString(fmt.Sprintf("Host vaws-%s\n", t.APIID))
+		b.WriteString(fmt.Sprintf("    HostName %s\n", t.JumpHost.PrivateIPAddress))
+		b.WriteString(fmt.Sprintf("    LocalForward %d %s:443\n\n", t.LocalPort, remoteHost))
+	}
+	return b.String()
+}
+
 // StartPublicTunnel starts a local HTTP proxy for public API Gateway.
 func (m *APIGatewayManager) StartPublicTunnel(ctx context.Context, api interface{}, stage model.APIStage, localPort int) (*model.APIGatewayTunnel, error) {
 	m.mu.Lock()
@@ -23,14 +23,41 @@ type Manager struct {
 	tunnels map[string]*activeTunnel
 	region  string
 	profile string
+
+	// restorable remembers tunnels that were active last session but whose
+	// processes died with it, so the UI can offer to re-establish them.
+	restorable []model.Tunnel
+
+	// Auto-reconnect policy (config tunnels section). Zero attempts
+	// disables reconnection.
+	reconnectAttempts int
+	reconnectBackoff  time.Duration
 }
 
 type activeTunnel struct {
 	model.Tunnel
-	cmd       *exec.Cmd
-	cancel    context.CancelFunc
-	stderrBuf *bytes.Buffer
-	process   *os.Process // For re-adopted tunnels where we only have the process
+	cmd        *exec.Cmd
+	cancel     context.CancelFunc
+	stderrBuf  *bytes.Buffer
+	process    *os.Process // For re-adopted tunnels where we only have the process
+	reconnects int         // Reconnect attempts consumed for this tunnel
+
+	// The SSM session listens on ssmPort; relay owns the user-facing port
+	// and counts traffic. Nil for tunnels re-adopted from a version that
+	// listened on the user port directly.
+	ssmPort int
+	relay   *tunnelRelay
+}
+
+// snapshot copies the tunnel model with current traffic counters folded in.
+func (t *activeTunnel) snapshot() model.Tunnel {
+	tn := t.Tunnel
+	if t.relay != nil {
+		tn.BytesIn = t.relay.bytesIn.Load()
+		tn.BytesOut = t.relay.bytesOut.Load()
+		tn.ActiveConns = int(t.relay.conns.Load())
+	}
+	return tn
 }
 
 // NewManager creates a new tunnel manager.
@@ -49,6 +76,16 @@ func NewManager(profile, region string) *Manager {
 	return m
 }
 
+// SetReconnectPolicy configures automatic reconnection of tunnels whose
+// SSM session dies unexpectedly. attempts == 0 disables it; backoff is the
+// delay before the first attempt and doubles for each further one.
+func (m *Manager) SetReconnectPolicy(attempts int, backoff time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.reconnectAttempts = attempts
+	m.reconnectBackoff = backoff
+}
+
 // StartTunnel starts a new port forwarding tunnel.
 func (m *Manager) StartTunnel(ctx context.Context, service model.Service, task model.Task, container model.Container, remotePort, localPort int) (*model.Tunnel, error) {
 	m.mu.Lock()
@@ -57,7 +94,7 @@ func (m *Manager) StartTunnel(ctx context.Context, service model.Service, task m
 	// Check if requested port is already in use by an active tunnel
 	if localPort != 0 {
 		for _, t := range m.tunnels {
-			if t.LocalPort == localPort && (t.Status == model.TunnelStatusActive || t.Status == model.TunnelStatusStarting) {
+			if t.LocalPort == localPort && (t.Status == model.TunnelStatusActive || t.Status == model.TunnelStatusStarting || t.Status == model.TunnelStatusReconnecting) {
 				return nil, fmt.Errorf("port %d is already in use by tunnel '%s'. Stop it first or use a different port", localPort, t.ID)
 			}
 		}
@@ -94,25 +131,28 @@ func (m *Manager) StartTunnel(ctx context.Context, service model.Service, task m
 		ClusterName:   service.ClusterName,
 		TaskID:        task.TaskID,
 		ContainerName: container.Name,
+		SSMTarget:     target,
 		Status:        model.TunnelStatusStarting,
 		StartedAt:     time.Now(),
 	}
 
-	// Build AWS SSM command
-	args := []string{
-		"ssm", "start-session",
-		"--target", target,
-		"--document-name", "AWS-StartPortForwardingSession",
-		"--parameters", fmt.Sprintf(`{"portNumber":["%d"],"localPortNumber":["%d"]}`, remotePort, localPort),
+	// The SSM session listens on an internal port; a local relay owns the
+	// user-facing port and counts traffic. The OS can hand back the
+	// just-released user port, which would make the relay dial itself.
+	ssmPort, err := findFreePort()
+	for i := 0; err == nil && ssmPort == localPort && i < 10; i++ {
+		ssmPort, err = findFreePort()
 	}
-
-	if m.region != "" {
-		args = append(args, "--region", m.region)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find internal port: %w", err)
 	}
-	if m.profile != "" {
-		args = append(args, "--profile", m.profile)
+	if ssmPort == localPort {
+		return nil, fmt.Errorf("failed to find internal port distinct from %d", localPort)
 	}
 
+	// Build AWS SSM command
+	args := m.startSessionArgs(target, remotePort, ssmPort)
+
 	// Create cancellable context for the process
 	// Use Background context so the tunnel isn't killed when the caller's context times out
 	cmdCtx, cancel := context.WithCancel(context.Background())
@@ -135,6 +175,16 @@ func (m *Manager) StartTunnel(ctx context.Context, service model.Service, task m
 		return &tunnel, fmt.Errorf("failed to start tunnel: %w", err)
 	}
 
+	relay, err := newTunnelRelay(localPort, ssmPort)
+	if err != nil {
+		cancel()
+		syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+		go cmd.Wait() // reap the killed process
+		tunnel.Status = model.TunnelStatusError
+		tunnel.Error = err.Error()
+		return &tunnel, fmt.Errorf("failed to start tunnel relay: %w", err)
+	}
+
 	tunnel.Status = model.TunnelStatusActive
 
 	// Store the active tunnel
@@ -143,6 +193,8 @@ func (m *Manager) StartTunnel(ctx context.Context, service model.Service, task m
 		cmd:       cmd,
 		cancel:    cancel,
 		stderrBuf: &stderrBuf,
+		ssmPort:   ssmPort,
+		relay:     relay,
 	}
 	m.tunnels[tunnelID] = at
 
@@ -162,14 +214,15 @@ func (m *Manager) StartTunnel(ctx context.Context, service model.Service, task m
 }
 
 // monitorTunnel watches a tunnel process and updates status when it exits.
+// Unexpected exits are retried per the reconnect policy; deliberate stops
+// (StopTunnel sets Terminated before the process dies) are left alone.
 func (m *Manager) monitorTunnel(id string, at *activeTunnel) {
 	err := at.cmd.Wait()
 
 	m.mu.Lock()
 
-	if t, exists := m.tunnels[id]; exists {
+	if t, exists := m.tunnels[id]; exists && t.Status != model.TunnelStatusTerminated {
 		if err != nil {
-			t.Status = model.TunnelStatusError
 			// Include stderr output in error message for better debugging
 			errMsg := err.Error()
 			if at.stderrBuf != nil && at.stderrBuf.Len() > 0 {
@@ -179,11 +232,30 @@ func (m *Manager) monitorTunnel(id string, at *activeTunnel) {
 				}
 			}
 			t.Error = errMsg
+
+			if m.reconnectAttempts > 0 && t.reconnects < m.reconnectAttempts && t.SSMTarget != "" {
+				t.reconnects++
+				attempt := t.reconnects
+				maxAttempts := m.reconnectAttempts
+				t.Status = model.TunnelStatusReconnecting
+				m.mu.Unlock()
+
+				log.Warn("Tunnel %s died unexpectedly (%s) - reconnecting (attempt %d/%d)", id, errMsg, attempt, maxAttempts)
+				go m.reconnectTunnel(id, attempt)
+				return
+			}
+
+			t.Status = model.TunnelStatusError
 			log.Error("Tunnel %s exited with error: %s", id, errMsg)
 		} else {
 			t.Status = model.TunnelStatusTerminated
 			log.Info("Tunnel %s terminated normally", id)
 		}
+
+		// The session is gone for good - release the user-facing port
+		if t.relay != nil {
+			t.relay.close()
+		}
 	}
 
 	m.mu.Unlock()
@@ -194,6 +266,64 @@ func (m *Manager) monitorTunnel(id string, at *activeTunnel) {
 	}
 }
 
+// reconnectTunnel re-establishes a tunnel on the same SSM target and local
+// port after a backoff that doubles per attempt. A StopTunnel or RemoveTunnel
+// while the backoff runs wins: the attempt is abandoned.
+func (m *Manager) reconnectTunnel(id string, attempt int) {
+	m.mu.RLock()
+	backoff := m.reconnectBackoff
+	m.mu.RUnlock()
+	time.Sleep(backoff << (attempt - 1))
+
+	m.mu.Lock()
+
+	t, exists := m.tunnels[id]
+	if !exists || t.Status != model.TunnelStatusReconnecting {
+		m.mu.Unlock()
+		return
+	}
+
+	// Reuse the same internal port so the relay (which keeps holding the
+	// user-facing port through the outage) needs no rewiring
+	listenPort := t.LocalPort
+	if t.ssmPort > 0 {
+		listenPort = t.ssmPort
+	}
+
+	args := m.startSessionArgs(t.SSMTarget, t.RemotePort, listenPort)
+	cmdCtx, cancel := context.WithCancel(context.Background())
+	cmd := exec.CommandContext(cmdCtx, "aws", args...)
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+
+	var stderrBuf bytes.Buffer
+	cmd.Stderr = &stderrBuf
+
+	if err := cmd.Start(); err != nil {
+		cancel()
+		t.Status = model.TunnelStatusError
+		t.Error = err.Error()
+		m.mu.Unlock()
+		log.Error("Tunnel %s reconnect failed: %v", id, err)
+		return
+	}
+
+	t.cmd = cmd
+	t.cancel = cancel
+	t.stderrBuf = &stderrBuf
+	t.process = nil
+	t.Status = model.TunnelStatusActive
+	t.Error = ""
+	localPort := t.LocalPort
+	m.mu.Unlock()
+
+	log.Info("Tunnel %s reconnected on localhost:%d", id, localPort)
+	go m.monitorTunnel(id, t)
+
+	if err := m.saveTunnels(); err != nil {
+		log.Debug("Failed to save tunnels: %v", err)
+	}
+}
+
 // StopTunnel stops an active tunnel.
 func (m *Manager) StopTunnel(id string) error {
 	m.mu.Lock()
@@ -231,6 +361,11 @@ func (m *Manager) StopTunnel(id string) error {
 		}
 	}
 
+	// Release the user-facing port
+	if tunnel.relay != nil {
+		tunnel.relay.close()
+	}
+
 	// Update status
 	tunnel.Status = model.TunnelStatusTerminated
 	localPort := tunnel.LocalPort
@@ -251,7 +386,8 @@ func (m *Manager) StopAllTunnels() {
 	m.mu.Lock()
 
 	for id, tunnel := range m.tunnels {
-		if tunnel.Status != model.TunnelStatusActive && tunnel.Status != model.TunnelStatusStarting {
+		if tunnel.Status != model.TunnelStatusActive && tunnel.Status != model.TunnelStatusStarting &&
+			tunnel.Status != model.TunnelStatusReconnecting {
 			continue
 		}
 		if tunnel.cancel != nil {
@@ -269,6 +405,9 @@ func (m *Manager) StopAllTunnels() {
 				tunnel.process.Kill()
 			}
 		}
+		if tunnel.relay != nil {
+			tunnel.relay.close()
+		}
 		tunnel.Status = model.TunnelStatusTerminated
 		log.Info("Stopped tunnel: %s", id)
 	}
@@ -281,6 +420,21 @@ func (m *Manager) StopAllTunnels() {
 	}
 }
 
+// RestorableTunnels returns tunnels that were active last session but died
+// with it - candidates for re-establishment with their saved local ports.
+func (m *Manager) RestorableTunnels() []model.Tunnel {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.restorable
+}
+
+// ClearRestorable forgets the restore candidates once they've been offered.
+func (m *Manager) ClearRestorable() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.restorable = nil
+}
+
 // GetTunnels returns all tunnels (active and terminated).
 func (m *Manager) GetTunnels() []model.Tunnel {
 	m.mu.RLock()
@@ -288,7 +442,7 @@ func (m *Manager) GetTunnels() []model.Tunnel {
 
 	tunnels := make([]model.Tunnel, 0, len(m.tunnels))
 	for _, t := range m.tunnels {
-		tunnels = append(tunnels, t.Tunnel)
+		tunnels = append(tunnels, t.snapshot())
 	}
 	return tunnels
 }
@@ -301,7 +455,7 @@ func (m *Manager) GetActiveTunnels() []model.Tunnel {
 	var tunnels []model.Tunnel
 	for _, t := range m.tunnels {
 		if t.Status == model.TunnelStatusActive || t.Status == model.TunnelStatusStarting {
-			tunnels = append(tunnels, t.Tunnel)
+			tunnels = append(tunnels, t.snapshot())
 		}
 	}
 	return tunnels
@@ -313,7 +467,8 @@ func (m *Manager) GetTunnel(id string) (*model.Tunnel, bool) {
 	defer m.mu.RUnlock()
 
 	if t, exists := m.tunnels[id]; exists {
-		return &t.Tunnel, true
+		tn := t.snapshot()
+		return &tn, true
 	}
 	return nil, false
 }
@@ -386,7 +541,7 @@ func (m *Manager) PrepareRestart(id string) (*model.Tunnel, error) {
 		return nil, fmt.Errorf("tunnel %s not found", id)
 	}
 
-	if t.Status == model.TunnelStatusActive || t.Status == model.TunnelStatusStarting {
+	if t.Status == model.TunnelStatusActive || t.Status == model.TunnelStatusStarting || t.Status == model.TunnelStatusReconnecting {
 		return nil, fmt.Errorf("tunnel %s is still active, stop it first", id)
 	}
 
@@ -399,6 +554,67 @@ func (m *Manager) PrepareRestart(id string) (*model.Tunnel, error) {
 	return &tunnelCopy, nil
 }
 
+// startSessionArgs builds the aws CLI arguments for an SSM port forwarding
+// session to target. ExportScript reuses this so exported scripts stay in
+// sync with what vaws actually runs.
+func (m *Manager) startSessionArgs(target string, remotePort, localPort int) []string {
+	args := []string{
+		"ssm", "start-session",
+		"--target", target,
+		"--document-name", "AWS-StartPortForwardingSession",
+		"--parameters", fmt.Sprintf(`{"portNumber":["%d"],"localPortNumber":["%d"]}`, remotePort, localPort),
+	}
+
+	if m.region != "" {
+		args = append(args, "--region", m.region)
+	}
+	if m.profile != "" {
+		args = append(args, "--profile", m.profile)
+	}
+	return args
+}
+
+// ExportScript renders the active tunnels as a runnable shell script of
+// aws ssm start-session commands. Returns "" when nothing is active.
+func (m *Manager) ExportScript() string {
+	active := m.GetActiveTunnels()
+
+	var b strings.Builder
+	wrote := false
+	for _, t := range active {
+		if t.SSMTarget == "" {
+			continue // re-adopted tunnel from an older version, target unknown
+		}
+		if !wrote {
+			b.WriteString("#!/bin/sh\n")
+			b.WriteString("# Generated by vaws - recreates the currently active SSM tunnels.\n")
+			b.WriteString("# ECS targets reference live task/runtime ids and go stale when\n")
+			b.WriteString("# tasks are replaced; re-export after a deployment.\n\n")
+			wrote = true
+		}
+		b.WriteString(fmt.Sprintf("# %s/%s -> localhost:%d\n", t.ServiceName, t.ContainerName, t.LocalPort))
+		b.WriteString("aws")
+		for _, arg := range m.startSessionArgs(t.SSMTarget, t.RemotePort, t.LocalPort) {
+			b.WriteString(" " + shellQuote(arg))
+		}
+		b.WriteString(" &\n")
+	}
+	if !wrote {
+		return ""
+	}
+	b.WriteString("\nwait\n")
+	return b.String()
+}
+
+// shellQuote single-quotes an argument when it contains characters the shell
+// would interpret.
+func shellQuote(s string) string {
+	if !strings.ContainsAny(s, " \t\"'{}[]$&|;<>*?()") {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
 // findFreePort finds an available port on localhost.
 func findFreePort() (int, error) {
 	listener, err := net.Listen("tcp", "127.0.0.1:0")
@@ -415,7 +631,7 @@ func (m *Manager) findFreePortExcludingActive() (int, error) {
 	// Collect ports used by active tunnels
 	usedPorts := make(map[int]bool)
 	for _, t := range m.tunnels {
-		if t.Status == model.TunnelStatusActive || t.Status == model.TunnelStatusStarting {
+		if t.Status == model.TunnelStatusActive || t.Status == model.TunnelStatusStarting || t.Status == model.TunnelStatusReconnecting {
 			usedPorts[t.LocalPort] = true
 		}
 	}
@@ -16,6 +16,7 @@ type persistedTunnel struct {
 	ID            string             `json:"id"`
 	PID           int                `json:"pid"`
 	LocalPort     int                `json:"local_port"`
+	SSMPort       int                `json:"ssm_port,omitempty"`
 	RemotePort    int                `json:"remote_port"`
 	ServiceName   string             `json:"service_name"`
 	ClusterARN    string             `json:"cluster_arn"`
@@ -53,6 +54,7 @@ func (m *Manager) saveTunnels() error {
 		pt := persistedTunnel{
 			ID:            t.ID,
 			LocalPort:     t.LocalPort,
+			SSMPort:       t.ssmPort,
 			RemotePort:    t.RemotePort,
 			ServiceName:   t.ServiceName,
 			ClusterARN:    t.ClusterARN,
@@ -133,8 +135,10 @@ func (m *Manager) loadTunnels() error {
 			Error:         pt.Error,
 		}
 
-		// For tunnels that were active, check if process is still running
-		if pt.Status == model.TunnelStatusActive || pt.Status == model.TunnelStatusStarting {
+		// For tunnels that were active (or mid-reconnect), check if the
+		// process is still running
+		if pt.Status == model.TunnelStatusActive || pt.Status == model.TunnelStatusStarting ||
+			pt.Status == model.TunnelStatusReconnecting {
 			if pt.PID > 0 && isProcessRunning(pt.PID) {
 				// Re-adopt the running tunnel
 				tunnel.Status = model.TunnelStatusActive
@@ -144,20 +148,35 @@ func (m *Manager) loadTunnels() error {
 					log.Debug("Could not find process %d: %v", pt.PID, err)
 					tunnel.Status = model.TunnelStatusTerminated
 				} else {
+					// The previous session's relay died with it; stand up
+					// a new one in front of the still-running SSM session
+					var relay *tunnelRelay
+					if pt.SSMPort > 0 {
+						relay, err = newTunnelRelay(pt.LocalPort, pt.SSMPort)
+						if err != nil {
+							log.Warn("Could not relay re-adopted tunnel %s: %v", pt.ID, err)
+							relay = nil
+						}
+					}
+
 					m.tunnels[pt.ID] = &activeTunnel{
 						Tunnel:    tunnel,
 						cmd:       nil,
 						cancel:    func() {},
 						stderrBuf: nil,
 						process:   process,
+						ssmPort:   pt.SSMPort,
+						relay:     relay,
 					}
 					adopted++
 					log.Info("Re-adopted tunnel: %s on localhost:%d (PID %d)", pt.ID, pt.LocalPort, pt.PID)
 					continue
 				}
 			} else {
-				// Process not running, mark as terminated
+				// Process not running: the tunnel died with the previous
+				// session, so offer it for re-establishment
 				tunnel.Status = model.TunnelStatusTerminated
+				m.restorable = append(m.restorable, tunnel)
 			}
 		} else {
 			// Keep the saved status for terminated/error tunnels
@@ -0,0 +1,95 @@
+package tunnel
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"sync/atomic"
+
+	"vaws/internal/log"
+)
+
+// tunnelRelay is a local TCP proxy in front of an SSM port-forward session.
+// The session-manager-plugin listens on an internal port; the relay owns the
+// user-facing port and counts the bytes it proxies in each direction, so the
+// tunnels panel can show whether a tunnel actually carries traffic.
+type tunnelRelay struct {
+	listener   net.Listener
+	targetPort int
+
+	bytesIn  atomic.Int64 // remote -> client
+	bytesOut atomic.Int64 // client -> remote
+	conns    atomic.Int32 // currently open client connections
+}
+
+// newTunnelRelay starts a relay listening on localPort that proxies every
+// connection to 127.0.0.1:targetPort.
+func newTunnelRelay(localPort, targetPort int) (*tunnelRelay, error) {
+	ln, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", localPort))
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on port %d: %w", localPort, err)
+	}
+
+	r := &tunnelRelay{listener: ln, targetPort: targetPort}
+	go r.acceptLoop()
+	return r, nil
+}
+
+func (r *tunnelRelay) acceptLoop() {
+	for {
+		conn, err := r.listener.Accept()
+		if err != nil {
+			return // listener closed
+		}
+		go r.handle(conn)
+	}
+}
+
+func (r *tunnelRelay) handle(client net.Conn) {
+	defer client.Close()
+
+	remote, err := net.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", r.targetPort))
+	if err != nil {
+		// The SSM session is not (yet) listening, e.g. still starting
+		// or mid-reconnect
+		log.Debug("Tunnel relay: dial 127.0.0.1:%d failed: %v", r.targetPort, err)
+		return
+	}
+	defer remote.Close()
+
+	r.conns.Add(1)
+	defer r.conns.Add(-1)
+
+	done := make(chan struct{})
+	go func() {
+		io.Copy(&countingWriter{w: remote, n: &r.bytesOut}, client)
+		if tc, ok := remote.(*net.TCPConn); ok {
+			tc.CloseWrite()
+		}
+		close(done)
+	}()
+
+	io.Copy(&countingWriter{w: client, n: &r.bytesIn}, remote)
+	if tc, ok := client.(*net.TCPConn); ok {
+		tc.CloseWrite()
+	}
+	<-done
+}
+
+// close stops the listener; in-flight connections finish on their own.
+func (r *tunnelRelay) close() {
+	r.listener.Close()
+}
+
+// countingWriter adds every written byte to a shared counter, so byte totals
+// advance while a connection is still open rather than when it closes.
+type countingWriter struct {
+	w io.Writer
+	n *atomic.Int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n.Add(int64(n))
+	return n, err
+}
@@ -0,0 +1,122 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"vaws/internal/state"
+	"vaws/internal/ui/components"
+	"vaws/internal/ui/theme"
+)
+
+// loadResourceAlarms fetches CloudWatch alarms for the selected queue or
+// Lambda function so existing monitoring context shows next to the resource.
+func (m *Model) loadResourceAlarms() tea.Cmd {
+	switch m.state.View {
+	case state.ViewSQS:
+		queue := m.sqsTable.SelectedQueue()
+		if queue == nil {
+			return nil
+		}
+		key := "sqs:" + queue.Name
+		name := queue.Name
+		m.logger.Info("Loading alarms for queue %s...", name)
+		return func() tea.Msg {
+			ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+			defer cancel()
+
+			alarms, err := m.client.GetAlarmsForResource(ctx, "AWS/SQS",
+				"ApproximateNumberOfMessagesVisible", map[string]string{"QueueName": name})
+			return alarmsLoadedMsg{key: key, alarms: alarms, err: err}
+		}
+
+	case state.ViewLambda:
+		item := m.lambdaList.SelectedItem()
+		if item == nil {
+			return nil
+		}
+		key := "lambda:" + item.ID
+		name := item.ID
+		m.logger.Info("Loading alarms for function %s...", name)
+		return func() tea.Msg {
+			ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+			defer cancel()
+
+			dims := map[string]string{"FunctionName": name}
+			errorsAlarms, err := m.client.GetAlarmsForResource(ctx, "AWS/Lambda", "Errors", dims)
+			if err != nil {
+				return alarmsLoadedMsg{key: key, err: err}
+			}
+			throttleAlarms, err := m.client.GetAlarmsForResource(ctx, "AWS/Lambda", "Throttles", dims)
+			if err != nil {
+				return alarmsLoadedMsg{key: key, err: err}
+			}
+			return alarmsLoadedMsg{key: key, alarms: append(errorsAlarms, throttleAlarms...), err: nil}
+		}
+	}
+	return nil
+}
+
+// alarmStateStyle colors an alarm state: ALARM red, OK green, anything else
+// (insufficient data) as a warning.
+func alarmStateStyle(alarmState string) lipgloss.Style {
+	switch alarmState {
+	case "ALARM":
+		return lipgloss.NewStyle().Foreground(theme.Error)
+	case "OK":
+		return lipgloss.NewStyle().Foreground(theme.Success)
+	default:
+		return lipgloss.NewStyle().Foreground(theme.Warning)
+	}
+}
+
+// alarmDetailRows renders loaded alarms for a resource key as detail rows.
+// Returns nil when alarms haven't been fetched for the key yet.
+func (m *Model) alarmDetailRows(key string) []components.DetailRow {
+	alarms, ok := m.resourceAlarms[key]
+	if !ok {
+		return nil
+	}
+
+	rows := []components.DetailRow{
+		{Label: "", Value: ""}, // Spacer
+	}
+	if len(alarms) == 0 {
+		rows = append(rows, components.DetailRow{
+			Label: "Alarms",
+			Value: "none configured",
+			Style: lipgloss.NewStyle().Foreground(theme.TextDim),
+		})
+		return rows
+	}
+
+	rows = append(rows, components.DetailRow{Label: "Alarms", Value: fmt.Sprintf("%d", len(alarms))})
+	for _, a := range alarms {
+		rows = append(rows, components.DetailRow{
+			Label: "  " + truncateString(a.Name, 14),
+			Value: fmt.Sprintf("%s (%s %s %.0f)", a.State, a.MetricName, comparisonSymbol(a.ComparisonOperator), a.Threshold),
+			Style: alarmStateStyle(a.State),
+		})
+	}
+	return rows
+}
+
+// comparisonSymbol shortens a CloudWatch comparison operator for display.
+func comparisonSymbol(op string) string {
+	switch op {
+	case "GreaterThanThreshold":
+		return ">"
+	case "GreaterThanOrEqualToThreshold":
+		return ">="
+	case "LessThanThreshold":
+		return "<"
+	case "LessThanOrEqualToThreshold":
+		return "<="
+	default:
+		return op
+	}
+}
@@ -0,0 +1,151 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"vaws/internal/model"
+	"vaws/internal/state"
+	"vaws/internal/ui/components"
+	"vaws/internal/ui/theme"
+)
+
+// List item ID prefixes in the aliases/versions view.
+const (
+	aliasIDPrefix   = "alias:"
+	versionIDPrefix = "version:"
+)
+
+// startAliasesView loads the selected function's aliases and versions.
+func (m *Model) startAliasesView() tea.Cmd {
+	item := m.lambdaList.SelectedItem()
+	if item == nil {
+		return nil
+	}
+	name := item.ID
+	m.aliasFn = name
+	m.aliasList.SetLoading(true)
+	m.state.View = state.ViewLambdaAliases
+	m.logger.Info("Loading aliases and versions for %s...", name)
+
+	return tea.Batch(
+		m.aliasList.Spinner().TickCmd(),
+		func() tea.Msg {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			aliases, err := m.client.ListAliases(ctx, name)
+			if err != nil {
+				return aliasesLoadedMsg{function: name, err: err}
+			}
+			versions, err := m.client.ListVersions(ctx, name)
+			return aliasesLoadedMsg{function: name, aliases: aliases, versions: versions, err: err}
+		},
+	)
+}
+
+// updateAliasList renders aliases and versions as one sectioned list.
+func (m *Model) updateAliasList() {
+	headerStyle := lipgloss.NewStyle().Foreground(theme.Primary).Bold(true)
+	var items []components.ListItem
+
+	if len(m.fnAliases) > 0 {
+		items = append(items, components.ListItem{ID: "hdr-aliases", Title: "── Aliases ──", IsHeader: true})
+		for _, alias := range m.fnAliases {
+			status := "-> " + alias.FunctionVersion
+			if len(alias.RoutingWeights) > 0 {
+				var parts []string
+				for version, weight := range alias.RoutingWeights {
+					parts = append(parts, fmt.Sprintf("%s:%.0f%%", version, weight*100))
+				}
+				sort.Strings(parts)
+				status += " (+" + strings.Join(parts, " ") + ")"
+			}
+			items = append(items, components.ListItem{
+				ID:          aliasIDPrefix + alias.Name,
+				Title:       alias.Name,
+				Status:      status,
+				StatusStyle: headerStyle,
+				Extra:       alias.Description,
+			})
+		}
+	}
+
+	if len(m.fnVersions) > 0 {
+		items = append(items, components.ListItem{ID: "hdr-versions", Title: "── Versions ──", IsHeader: true})
+		for _, version := range m.fnVersions {
+			items = append(items, components.ListItem{
+				ID:          versionIDPrefix + version.Version,
+				Title:       version.Version,
+				Status:      formatBytes(version.CodeSize),
+				StatusStyle: lipgloss.NewStyle().Foreground(theme.TextMuted),
+				Extra:       version.Description,
+			})
+		}
+	}
+
+	m.aliasList.SetItems(items)
+	m.aliasList.SetLoading(false)
+	m.aliasList.SetError(nil)
+	m.aliasList.SetEmptyMessage("No aliases or versions published")
+	m.aliasList.Top()
+}
+
+// handleAliasesLoaded applies loaded aliases/versions.
+func (m *Model) handleAliasesLoaded(msg aliasesLoadedMsg) {
+	if msg.err != nil {
+		m.aliasList.SetLoading(false)
+		m.aliasList.SetError(msg.err)
+		m.logger.Error("Failed to load aliases for %s: %v", msg.function, msg.err)
+		return
+	}
+	m.fnAliases = msg.aliases
+	m.fnVersions = msg.versions
+	m.updateAliasList()
+}
+
+// invokeSelectedQualifier opens the payload dialog targeting the selected
+// alias or version via Qualifier.
+func (m *Model) invokeSelectedQualifier() tea.Cmd {
+	item := m.aliasList.SelectedItem()
+	if item == nil {
+		return nil
+	}
+
+	var qualifier string
+	switch {
+	case strings.HasPrefix(item.ID, aliasIDPrefix):
+		qualifier = strings.TrimPrefix(item.ID, aliasIDPrefix)
+	case strings.HasPrefix(item.ID, versionIDPrefix):
+		qualifier = strings.TrimPrefix(item.ID, versionIDPrefix)
+	default:
+		return nil
+	}
+
+	// The payload dialog needs the function model for its invoke path
+	var fn *model.Function
+	for i := range m.state.Functions {
+		if m.state.Functions[i].Name == m.aliasFn {
+			fn = &m.state.Functions[i]
+			break
+		}
+	}
+	if fn == nil {
+		return nil
+	}
+
+	m.invokeQualifier = qualifier
+	m.enteringPayload = true
+	m.pendingInvokeFunction = fn
+	m.payloadInput.Reset()
+	m.payloadInput.Focus()
+	m.preflightWarning = ""
+	m.logger.Info("Invoking %s with qualifier %s", fn.Name, qualifier)
+	return nil
+}
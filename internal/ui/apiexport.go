@@ -0,0 +1,83 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// exportAPISpec writes the selected stage's API definition to a file named
+// after the API and stage, as OpenAPI 3 JSON or YAML.
+func (m *Model) exportAPISpec(asYAML bool) tea.Cmd {
+	item := m.apiStagesList.SelectedItem()
+	if item == nil {
+		m.logger.Warn("Export: no stage selected")
+		return nil
+	}
+	stageName := item.ID
+
+	var apiID, apiName string
+	isRest := false
+	switch {
+	case m.state.SelectedRestAPI != nil:
+		apiID, apiName, isRest = m.state.SelectedRestAPI.ID, m.state.SelectedRestAPI.Name, true
+	case m.state.SelectedHttpAPI != nil:
+		apiID, apiName = m.state.SelectedHttpAPI.ID, m.state.SelectedHttpAPI.Name
+	default:
+		return nil
+	}
+
+	ext := "json"
+	if asYAML {
+		ext = "yaml"
+	}
+	m.logger.Info("Exporting %s (%s) as OpenAPI %s...", apiName, stageName, ext)
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		var data []byte
+		var err error
+		if isRest {
+			data, err = m.client.ExportRestAPI(ctx, apiID, stageName, "oas30", asYAML)
+		} else {
+			data, err = m.client.ExportHTTPAPI(ctx, apiID, stageName, asYAML)
+		}
+		if err != nil {
+			return apiExportedMsg{err: err}
+		}
+
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return apiExportedMsg{err: err}
+		}
+		// Keep the name filesystem-safe; API names may contain separators
+		safeName := strings.ReplaceAll(apiName, "/", "-")
+		safeName = strings.ReplaceAll(safeName, " ", "-")
+		path := filepath.Join(home, ".vaws", "exports", fmt.Sprintf("%s-%s.%s", safeName, stageName, ext))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return apiExportedMsg{err: err}
+		}
+		if err := os.WriteFile(path, data, 0644); err != nil {
+			return apiExportedMsg{err: err}
+		}
+		return apiExportedMsg{path: path}
+	}
+}
+
+// handleAPIExported reports where the spec landed.
+func (m *Model) handleAPIExported(msg apiExportedMsg) {
+	if msg.err != nil {
+		m.logger.Error("Failed to export API spec: %v", msg.err)
+		m.state.ShowLogs = true
+		m.updateComponentSizes()
+		return
+	}
+	m.logger.Info("API spec exported to %s", msg.path)
+}
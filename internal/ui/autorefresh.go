@@ -0,0 +1,131 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"vaws/internal/state"
+	"vaws/internal/ui/components"
+)
+
+// autoRefreshKey maps the active view to its auto_refresh config key, or ""
+// for views without auto-refresh.
+func (m *Model) autoRefreshKey() string {
+	switch m.state.View {
+	case state.ViewStacks:
+		return "stacks"
+	case state.ViewServices:
+		return "ecs"
+	case state.ViewLambda:
+		return "lambda"
+	case state.ViewSQS:
+		return "sqs"
+	case state.ViewDynamoDB:
+		return "dynamodb"
+	}
+	return ""
+}
+
+// autoRefreshInterval returns the configured interval for a resource key.
+// Stacks and services keep the historical built-in cadence when not
+// configured; other resources are off unless configured.
+func (m *Model) autoRefreshInterval(key string) time.Duration {
+	if key == "" {
+		return 0
+	}
+	if m.cfg != nil {
+		if secs, ok := m.cfg.AutoRefresh[key]; ok {
+			return time.Duration(secs) * time.Second
+		}
+	}
+	if key == "stacks" || key == "ecs" {
+		return components.DefaultRefreshInterval
+	}
+	return 0
+}
+
+// dialogOpen reports whether a modal dialog or input flow is active.
+// Auto-refresh pauses meanwhile so a reload doesn't yank list state out
+// from under a confirmation.
+func (m *Model) dialogOpen() bool {
+	return m.filtering || m.cwFiltering || m.cwPatternEditing || m.detailsSearching ||
+		m.globalSearching || m.commandPalette.IsActive() ||
+		m.enteringPort || m.enteringPayload || m.enteringTemplateParam ||
+		m.esmToggleActive || m.lambdaEditStage != lambdaEditStageNone ||
+		m.ec2ActionActive || m.stageVarActive || m.tunnelReqStage != tunnelReqStageNone ||
+		m.putItemGuardActive || m.indexPickActive || m.itemDeleteConfirmActive ||
+		m.deleteMsgConfirmActive || m.purgeConfirmActive || m.structuredEditor.IsActive() ||
+		m.sendMsgStage != sendMsgStageNone || m.scanConfirmActive || m.outputRouteActive ||
+		m.forceDeployConfirmActive || m.scaleWatchActive || m.redriveActive ||
+		m.ctxSwitchActive || m.ssoLoginActive || m.helpActive || m.dynamodbQueryDialog.IsActive()
+}
+
+// applyAutoRefreshConfig tightens the tick cadence to the fastest configured
+// interval so settings below the built-in 10s actually take effect.
+func (m *Model) applyAutoRefreshConfig() {
+	if m.cfg == nil {
+		return
+	}
+	tick := components.DefaultRefreshInterval
+	for _, secs := range m.cfg.AutoRefresh {
+		if d := time.Duration(secs) * time.Second; secs > 0 && d < tick {
+			tick = d
+		}
+	}
+	m.refreshIndicator.SetInterval(tick)
+}
+
+// autoRefreshTick re-runs the active view's loader when its configured
+// interval has elapsed. The incremental loaders ignore retriggers while a
+// stream is in flight, so overlapping loads don't mix results.
+func (m *Model) autoRefreshTick() tea.Cmd {
+	if m.dialogOpen() {
+		return nil
+	}
+
+	key := m.autoRefreshKey()
+	interval := m.autoRefreshInterval(key)
+	if interval <= 0 {
+		return nil
+	}
+
+	if m.lastAutoRefresh == nil {
+		m.lastAutoRefresh = make(map[string]time.Time)
+	}
+	if time.Since(m.lastAutoRefresh[key]) < interval {
+		return nil
+	}
+	m.lastAutoRefresh[key] = time.Now()
+
+	// Auto-refresh exists to show fresh numbers - bypass the describe cache
+	m.client.InvalidateDescribeCache()
+
+	switch key {
+	case "stacks":
+		return m.loadStacks()
+	case "ecs":
+		return m.loadServices()
+	case "lambda":
+		return m.loadFunctions()
+	case "sqs":
+		return m.loadQueues()
+	case "dynamodb":
+		return m.loadTables()
+	}
+	return nil
+}
+
+// autoRefreshSuffix annotates the container context when auto-refresh is
+// active for the current view.
+func (m *Model) autoRefreshSuffix() string {
+	if !m.state.AutoRefresh {
+		return ""
+	}
+	interval := m.autoRefreshInterval(m.autoRefreshKey())
+	if interval <= 0 {
+		return ""
+	}
+	return fmt.Sprintf(" • auto-refresh: %ds", int(interval.Seconds()))
+}
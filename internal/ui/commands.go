@@ -1,10 +1,15 @@
 package ui
 
 import (
+	"os"
+	"path/filepath"
+
 	tea "github.com/charmbracelet/bubbletea"
 
+	"vaws/internal/config"
 	"vaws/internal/state"
 	"vaws/internal/ui/components"
+	"vaws/internal/ui/theme"
 )
 
 // executeCommand executes a command from the command palette.
@@ -47,8 +52,17 @@ func (m *Model) executeCommand(result *components.CommandResult) tea.Cmd {
 	case "region":
 		// Show region picker - save current view to return to it
 		m.viewBeforeRegionSelect = m.state.View
+		m.regionSelector.ClearFilter()
 		m.regionSelector.SetCurrentRegion(m.state.Region)
 		m.state.View = state.ViewRegionSelect
+		return m.probeRegionLatency(m.regionSelector.SelectedRegion())
+
+	case "theme":
+		if len(result.Args) == 0 {
+			m.logger.Info("Theme: %s (use :theme dark|light|auto)", theme.Current().Name)
+			return nil
+		}
+		m.switchTheme(result.Args[0])
 		return nil
 
 	// Actions
@@ -69,6 +83,37 @@ func (m *Model) executeCommand(result *components.CommandResult) tea.Cmd {
 		m.showHelp()
 		return nil
 
+	case "reset":
+		// Reset a config section to defaults
+		if len(result.Args) == 0 {
+			m.logger.Error("Usage: reset <filters|templates|defaults>")
+			return nil
+		}
+		m.resetConfigSection(result.Args[0])
+		return nil
+
+	case "export-tunnels":
+		// Export active tunnels as a script or SSH config
+		format := "sh"
+		path := ""
+		if len(result.Args) > 0 {
+			format = result.Args[0]
+		}
+		if len(result.Args) > 1 {
+			path = result.Args[1]
+		}
+		m.exportTunnels(format, path)
+		return nil
+
+	case "keys":
+		// Export the full keybinding reference (optional path argument)
+		path := ""
+		if len(result.Args) > 0 {
+			path = result.Args[0]
+		}
+		m.exportKeybindings(path)
+		return nil
+
 	case "quit":
 		if m.tunnelManager != nil {
 			m.tunnelManager.StopAllTunnels()
@@ -81,6 +126,61 @@ func (m *Model) executeCommand(result *components.CommandResult) tea.Cmd {
 	}
 }
 
+// resetConfigSection restores one config section to its built-in defaults
+// and persists the change. Comments in the file are not preserved.
+func (m *Model) resetConfigSection(section string) {
+	if m.cfg == nil {
+		m.logger.Error("No configuration loaded")
+		return
+	}
+
+	switch section {
+	case "filters":
+		m.cfg.Filters = nil
+		m.state.DefaultFilters = nil
+		m.state.DefaultHidden = nil
+	case "templates":
+		m.cfg.QueryTemplates = nil
+		m.queryTemplates = nil
+	case "defaults":
+		m.cfg.Defaults = config.DefaultSettings()
+	default:
+		m.logger.Error("Unknown section %q (filters, templates, defaults)", section)
+		return
+	}
+
+	if err := m.cfg.Save(); err != nil {
+		m.logger.Error("Failed to save config: %v", err)
+		return
+	}
+	m.logger.Warn("Reset %s to defaults and rewrote %s (file comments are not preserved)", section, config.DefaultConfigPath())
+	m.updateCurrentList()
+}
+
+// exportKeybindings writes the full keybinding reference to path
+// (default ~/.vaws/keybindings.md) and reports where it landed.
+func (m *Model) exportKeybindings(path string) {
+	if path == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			m.logger.Error("Failed to resolve home directory: %v", err)
+			return
+		}
+		path = filepath.Join(home, ".vaws", "keybindings.md")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		m.logger.Error("Failed to create directory for %s: %v", path, err)
+		return
+	}
+	if err := os.WriteFile(path, []byte(m.keys.RenderMarkdown()), 0644); err != nil {
+		m.logger.Error("Failed to write keybinding reference: %v", err)
+		return
+	}
+
+	m.logger.Info("Keybinding reference exported to %s", path)
+}
+
 // switchToMain switches to the main menu view.
 func (m *Model) switchToMain() tea.Cmd {
 	m.state.SelectedStack = nil
@@ -161,3 +261,23 @@ func (m *Model) switchToStacks() tea.Cmd {
 	m.updateStacksList()
 	return nil
 }
+
+// switchTheme applies and persists a theme choice at runtime.
+func (m *Model) switchTheme(name string) {
+	switch name {
+	case "dark", "light", "auto":
+	default:
+		m.logger.Error("Unknown theme %q (use dark, light, auto)", name)
+		return
+	}
+
+	theme.SetByName(theme.ThemeType(name))
+	m.logger.Info("Theme switched to %s", name)
+
+	if m.cfg != nil {
+		m.cfg.Defaults.Theme = name
+		if err := m.cfg.Save(); err != nil {
+			m.logger.Warn("Failed to persist theme to config: %v", err)
+		}
+	}
+}
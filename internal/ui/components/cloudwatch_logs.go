@@ -2,6 +2,7 @@ package components
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"sync"
 	"time"
@@ -39,6 +40,8 @@ type CloudWatchLogsPanel struct {
 	spinnerFrame int
 	serviceName  string
 	taskID       string
+	filter       string         // Client-side text filter over loaded entries
+	filterRe     *regexp.Regexp // Set when the filter uses /regexp mode
 }
 
 // NewCloudWatchLogsPanel creates a new CloudWatch logs panel.
@@ -136,6 +139,44 @@ func (p *CloudWatchLogsPanel) SetSize(width, height int) {
 	p.height = height
 }
 
+// SetFilter sets a case-insensitive substring filter over the loaded
+// entries; a leading '/' switches to regexp mode. The stored entries are
+// untouched, so clearing the filter restores the full view.
+func (p *CloudWatchLogsPanel) SetFilter(query string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.filter = query
+	p.filterRe = nil
+	if strings.HasPrefix(query, "/") && len(query) > 1 {
+		if re, err := regexp.Compile("(?i)" + query[1:]); err == nil {
+			p.filterRe = re
+		}
+	}
+	p.scroll = 0
+}
+
+// matchesFilterLocked reports whether a message passes the text filter.
+func (p *CloudWatchLogsPanel) matchesFilterLocked(message string) bool {
+	if p.filter == "" {
+		return true
+	}
+	if p.filterRe != nil {
+		return p.filterRe.MatchString(message)
+	}
+	return strings.Contains(strings.ToLower(message), strings.ToLower(p.filter))
+}
+
+// highlightFilterLocked wraps filter matches in the highlight style.
+func (p *CloudWatchLogsPanel) highlightFilterLocked(message string) string {
+	hl := lipgloss.NewStyle().Background(theme.BgHighlight)
+	if p.filterRe != nil {
+		return p.filterRe.ReplaceAllStringFunc(message, func(match string) string {
+			return hl.Render(match)
+		})
+	}
+	return highlightSegments(message, p.filter, lipgloss.NewStyle(), hl)
+}
+
 // SetStreaming sets streaming state.
 func (p *CloudWatchLogsPanel) SetStreaming(streaming bool) {
 	p.mu.Lock()
@@ -233,6 +274,9 @@ func (p *CloudWatchLogsPanel) maxScrollLocked() int {
 	if p.streaming || len(p.containers) > 0 {
 		headerLines++ // streaming/container header
 	}
+	if p.filter != "" {
+		headerLines++ // filter status line
+	}
 	visibleLines := p.height - headerLines
 	if visibleLines < 1 {
 		visibleLines = 1
@@ -247,18 +291,34 @@ func (p *CloudWatchLogsPanel) maxScrollLocked() int {
 }
 
 func (p *CloudWatchLogsPanel) filteredEntriesCountLocked() int {
-	if len(p.containers) == 0 || p.selectedTab >= len(p.containers) {
-		return len(p.entries)
+	return len(p.filteredEntriesLocked())
+}
+
+// filteredEntriesLocked applies the container-stream selection and the
+// client-side text filter to the loaded entries.
+func (p *CloudWatchLogsPanel) filteredEntriesLocked() []model.CloudWatchLogEntry {
+	var streamEntries []model.CloudWatchLogEntry
+	if len(p.containers) > 0 && p.selectedTab < len(p.containers) {
+		selectedStream := p.containers[p.selectedTab].LogStreamName
+		for _, e := range p.entries {
+			if e.LogStreamName == selectedStream {
+				streamEntries = append(streamEntries, e)
+			}
+		}
+	} else {
+		streamEntries = p.entries
 	}
 
-	selectedStream := p.containers[p.selectedTab].LogStreamName
-	count := 0
-	for _, e := range p.entries {
-		if e.LogStreamName == selectedStream {
-			count++
+	if p.filter == "" {
+		return streamEntries
+	}
+	var filtered []model.CloudWatchLogEntry
+	for _, e := range streamEntries {
+		if p.matchesFilterLocked(e.Message) {
+			filtered = append(filtered, e)
 		}
 	}
-	return count
+	return filtered
 }
 
 // Clear clears all entries.
@@ -319,17 +379,14 @@ func (p *CloudWatchLogsPanel) View() string {
 	// Log entries
 	st := theme.DefaultStyles()
 
-	// Filter entries for selected container
-	var filteredEntries []model.CloudWatchLogEntry
-	if len(p.containers) > 0 && p.selectedTab < len(p.containers) {
-		selectedStream := p.containers[p.selectedTab].LogStreamName
-		for _, e := range p.entries {
-			if e.LogStreamName == selectedStream {
-				filteredEntries = append(filteredEntries, e)
-			}
-		}
-	} else {
-		filteredEntries = p.entries
+	// Filter entries for the selected container and active text filter
+	filteredEntries := p.filteredEntriesLocked()
+
+	// Show the live match count while a filter is active
+	if p.filter != "" {
+		filterStyle := lipgloss.NewStyle().Foreground(theme.Info)
+		b.WriteString(filterStyle.Render(fmt.Sprintf("Filter %q: matched %d of %d", p.filter, len(filteredEntries), len(p.entries))))
+		b.WriteString("\n")
 	}
 
 	if len(filteredEntries) == 0 {
@@ -342,6 +399,9 @@ func (p *CloudWatchLogsPanel) View() string {
 		if p.streaming || len(p.containers) > 0 {
 			headerLines++ // streaming/container header
 		}
+		if p.filter != "" {
+			headerLines++ // filter status line
+		}
 		maxVisible := p.height - headerLines
 		if maxVisible < 1 {
 			maxVisible = 1
@@ -380,6 +440,11 @@ func (p *CloudWatchLogsPanel) View() string {
 				truncated = true
 			}
 
+			// Highlight why the entry matched the text filter
+			if p.filter != "" {
+				message = p.highlightFilterLocked(message)
+			}
+
 			line := fmt.Sprintf("%s %s", timeStyle.Render(timeStr), message)
 
 			// Add truncation indicator
@@ -30,14 +30,18 @@ var AvailableCommands = []Command{
 
 	// Other views
 	{Name: "tunnels", Aliases: []string{"tun", "tunnel", "pf"}, Description: "Port forward tunnels"},
+	{Name: "export-tunnels", Aliases: []string{"export"}, Description: "Export tunnels as script/SSH config"},
 
 	// Settings
 	{Name: "region", Aliases: []string{"reg"}, Description: "Change AWS region"},
+	{Name: "theme", Description: "Switch color theme (dark/light/auto)"},
 
 	// Actions
 	{Name: "refresh", Aliases: []string{"reload"}, Description: "Refresh current view"},
 	{Name: "logs", Aliases: []string{"log", "l"}, Description: "Toggle logs panel"},
 	{Name: "help", Aliases: []string{"h", "?"}, Description: "Show help"},
+	{Name: "keys", Aliases: []string{"keybindings", "cheatsheet"}, Description: "Export keybinding reference"},
+	{Name: "reset", Description: "Reset a config section to defaults"},
 	{Name: "quit", Aliases: []string{"q", "exit"}, Description: "Quit application"},
 }
 
@@ -12,21 +12,22 @@ import (
 
 // DynamoDBQueryResults displays query/scan results.
 type DynamoDBQueryResults struct {
-	width        int
-	height       int
-	items        []model.DynamoDBItem
-	cursor       int
-	scrollOffset int
-	hasMorePages bool
-	count        int
-	scannedCount int
-	capacity     float64
-	tableName    string
-	pkName       string
-	skName       string
-	loading      bool
-	err          error
-	jsonScroll   int // Scroll offset for JSON panel
+	width         int
+	height        int
+	items         []model.DynamoDBItem
+	cursor        int
+	scrollOffset  int
+	hasMorePages  bool
+	count         int
+	scannedCount  int
+	capacity      float64
+	totalCapacity float64 // Accumulated across pages of the same query/scan
+	tableName     string
+	pkName        string
+	skName        string
+	loading       bool
+	err           error
+	jsonScroll    int // Scroll offset for JSON panel
 }
 
 // NewDynamoDBQueryResults creates a new results panel.
@@ -47,6 +48,7 @@ func (r *DynamoDBQueryResults) SetResult(result *model.QueryResult, tableName, p
 	r.count = result.Count
 	r.scannedCount = result.ScannedCount
 	r.capacity = result.ConsumedCapacity
+	r.totalCapacity += result.ConsumedCapacity
 	r.tableName = tableName
 	r.pkName = pkName
 	r.skName = skName
@@ -78,6 +80,7 @@ func (r *DynamoDBQueryResults) Clear() {
 	r.count = 0
 	r.scannedCount = 0
 	r.capacity = 0
+	r.totalCapacity = 0
 }
 
 // Up moves the cursor up.
@@ -254,15 +257,33 @@ func (r *DynamoDBQueryResults) renderList(width int) string {
 	statusStyle := lipgloss.NewStyle().
 		Foreground(theme.TextDim)
 
-	// Status line
+	// Status line - doubles as a query-cost profiler
 	status := fmt.Sprintf("Items: %d", r.count)
 	if r.hasMorePages {
 		status += " (more available)"
 	}
+	b.WriteString(statusStyle.Render(status))
+
+	// ScannedCount vs Count exposes filter efficiency on scans: a low match
+	// ratio means most of the read capacity was spent on discarded items.
+	if r.scannedCount > 0 && r.scannedCount != r.count {
+		ratio := float64(r.count) / float64(r.scannedCount)
+		scanned := fmt.Sprintf(" | Scanned: %d (%.0f%% matched)", r.scannedCount, ratio*100)
+		if ratio < 0.5 {
+			warnStyle := lipgloss.NewStyle().Foreground(theme.Warning)
+			b.WriteString(warnStyle.Render(scanned))
+		} else {
+			b.WriteString(statusStyle.Render(scanned))
+		}
+	}
+
 	if r.capacity > 0 {
-		status += fmt.Sprintf(" | RCU: %.2f", r.capacity)
+		capacity := fmt.Sprintf(" | RCU: %.2f", r.capacity)
+		if r.totalCapacity > r.capacity {
+			capacity += fmt.Sprintf(" (total %.2f)", r.totalCapacity)
+		}
+		b.WriteString(statusStyle.Render(capacity))
 	}
-	b.WriteString(statusStyle.Render(status))
 	b.WriteString("\n")
 
 	// Column header
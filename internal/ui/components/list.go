@@ -31,6 +31,7 @@ type List struct {
 	loading   bool
 	errMsg    string
 	emptyMsg  string
+	highlight string // Filter text highlighted within matching rows
 	spinner   *Spinner
 }
 
@@ -94,6 +95,11 @@ func (l *List) SetEmptyMessage(msg string) {
 	l.emptyMsg = msg
 }
 
+// SetHighlight sets the filter text to highlight within matching rows.
+func (l *List) SetHighlight(query string) {
+	l.highlight = query
+}
+
 // Cursor returns the current cursor position.
 func (l *List) Cursor() int {
 	return l.cursor
@@ -140,6 +146,30 @@ func (l *List) Bottom() {
 }
 
 // skipHeadersDown moves cursor down to skip any headers.
+// ClickRow moves the cursor to the row at y, counted from the top of the
+// list's rendered block. Returns true when a selectable item was hit.
+func (l *List) ClickRow(y int) bool {
+	if l.loading || l.errMsg != "" || len(l.items) == 0 {
+		return false
+	}
+	if l.showTitle {
+		y--
+	}
+	if y < 0 {
+		return false
+	}
+
+	idx := l.offset + y
+	if idx >= len(l.items) || idx >= l.offset+l.visibleItemCount() {
+		return false
+	}
+	if l.items[idx].IsHeader {
+		return false
+	}
+	l.cursor = idx
+	return true
+}
+
 func (l *List) skipHeadersDown() {
 	for l.cursor < len(l.items) && l.items[l.cursor].IsHeader {
 		l.cursor++
@@ -272,6 +302,13 @@ func (l *List) View() string {
 
 		if isSelected {
 			line.WriteString(s.SidebarSelected.Render(namePadded))
+		} else if l.highlight != "" {
+			// Show why the row matched the filter. The name is padded before
+			// styling so escape codes don't break column alignment; the two
+			// leading spaces replace SidebarItem's padding.
+			base := lipgloss.NewStyle().Foreground(theme.Text)
+			hl := lipgloss.NewStyle().Background(theme.BgHighlight).Foreground(theme.Text)
+			line.WriteString("  " + highlightSegments(namePadded, l.highlight, base, hl))
 		} else {
 			line.WriteString(s.SidebarItem.Render(namePadded))
 		}
@@ -90,6 +90,11 @@ func (q *QuickBar) SetContextActions(actions []QuickKey) {
 	q.actionKeys = append(actions, standardActions...)
 }
 
+// ActionKeys returns the current action keys (view-specific plus standard).
+func (q *QuickBar) ActionKeys() []QuickKey {
+	return q.actionKeys
+}
+
 // ClearContextActions resets to default action keys.
 func (q *QuickBar) ClearContextActions() {
 	q.actionKeys = []QuickKey{
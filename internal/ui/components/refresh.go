@@ -86,6 +86,11 @@ func (r *RefreshIndicator) TimeSinceRefresh() time.Duration {
 	return time.Since(r.lastRefresh)
 }
 
+// LastRefresh returns when the last refresh completed.
+func (r *RefreshIndicator) LastRefresh() time.Time {
+	return r.lastRefresh
+}
+
 // View renders the refresh indicator
 func (r *RefreshIndicator) View() string {
 	if !r.enabled {
@@ -1,10 +1,18 @@
 package components
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/charmbracelet/lipgloss"
+	"vaws/internal/config"
 	"vaws/internal/ui/theme"
 )
 
+// highLatencyThreshold flags regions that will feel sluggish to browse.
+const highLatencyThreshold = 300 * time.Millisecond
+
 // AWSRegions lists common AWS regions grouped by geography
 var AWSRegions = []RegionGroup{
 	{
@@ -66,7 +74,10 @@ type RegionSelector struct {
 	cursor        int
 	offset        int
 	currentRegion string
-	flatRegions   []Region // Flattened list for navigation
+	filter        string                   // Type-to-filter text over code and name
+	flatRegions   []Region                 // Flattened (and filtered) list for navigation
+	customRegions []Region                 // User-entered regions persisted via config
+	latencies     map[string]time.Duration // Measured round-trip latency per region
 }
 
 // NewRegionSelector creates a new RegionSelector
@@ -86,6 +97,22 @@ func flattenRegions() []Region {
 	return regions
 }
 
+// SetCustomRegions installs previously used custom region codes. They take
+// part in navigation and filtering after the built-in groups, which stay
+// intact.
+func (r *RegionSelector) SetCustomRegions(codes []string) {
+	r.customRegions = nil
+	for _, code := range codes {
+		r.customRegions = append(r.customRegions, Region{Code: code, Name: "custom"})
+	}
+	r.applyFilter()
+}
+
+// allRegions returns the built-in groups flattened plus custom regions.
+func (r *RegionSelector) allRegions() []Region {
+	return append(flattenRegions(), r.customRegions...)
+}
+
 // SetSize sets the selector dimensions
 func (r *RegionSelector) SetSize(width, height int) {
 	r.width = width
@@ -105,6 +132,61 @@ func (r *RegionSelector) SetCurrentRegion(region string) {
 	}
 }
 
+// TypeFilter appends typed characters to the filter, matching against both
+// region code and friendly name ("ire" narrows to eu-west-1 Ireland).
+func (r *RegionSelector) TypeFilter(s string) {
+	r.filter += s
+	r.applyFilter()
+}
+
+// BackspaceFilter removes the last filter character.
+func (r *RegionSelector) BackspaceFilter() {
+	if len(r.filter) > 0 {
+		r.filter = r.filter[:len(r.filter)-1]
+		r.applyFilter()
+	}
+}
+
+// ClearFilter resets the filter to show all regions, re-seating the cursor
+// on the current region.
+func (r *RegionSelector) ClearFilter() {
+	r.filter = ""
+	r.applyFilter()
+	r.SetCurrentRegion(r.currentRegion)
+}
+
+// HasFilter reports whether a filter is active.
+func (r *RegionSelector) HasFilter() bool {
+	return r.filter != ""
+}
+
+// applyFilter narrows flatRegions to matches on code or friendly name.
+func (r *RegionSelector) applyFilter() {
+	if r.filter == "" {
+		r.flatRegions = r.allRegions()
+	} else {
+		q := strings.ToLower(r.filter)
+		var filtered []Region
+		for _, region := range r.allRegions() {
+			if strings.Contains(strings.ToLower(region.Code), q) ||
+				strings.Contains(strings.ToLower(region.Name), q) {
+				filtered = append(filtered, region)
+			}
+		}
+		r.flatRegions = filtered
+	}
+	r.cursor = 0
+	r.offset = 0
+}
+
+// SetLatency records a measured round-trip latency for a region.
+func (r *RegionSelector) SetLatency(code string, latency time.Duration) {
+	if r.latencies == nil {
+		r.latencies = make(map[string]time.Duration)
+	}
+	r.latencies[code] = latency
+}
+
 // Up moves cursor up
 func (r *RegionSelector) Up() {
 	if r.cursor > 0 {
@@ -121,11 +203,23 @@ func (r *RegionSelector) Down() {
 	}
 }
 
-// SelectedRegion returns the currently selected region code
+// SelectedRegion returns the currently selected region code, or the typed
+// custom region when the filter matches nothing known.
 func (r *RegionSelector) SelectedRegion() string {
 	if r.cursor >= 0 && r.cursor < len(r.flatRegions) {
 		return r.flatRegions[r.cursor].Code
 	}
+	return r.CustomRegion()
+}
+
+// CustomRegion returns the filter text when it matches no known region but
+// is itself a plausible region code (including gov/iso variants), offering
+// it as a custom selection. Uses the same validator as config, so anything
+// the selector accepts the config accepts.
+func (r *RegionSelector) CustomRegion() string {
+	if len(r.flatRegions) == 0 && config.IsValidRegion(r.filter) {
+		return r.filter
+	}
 	return ""
 }
 
@@ -182,7 +276,19 @@ func (r *RegionSelector) View() string {
 
 	var content string
 	content += titleStyle.Render("Select AWS Region") + "\n"
-	content += subtitleStyle.Render("Current: "+r.currentRegion) + "\n\n"
+	if r.filter != "" {
+		content += subtitleStyle.Render("Filter: "+r.filter+"█") + "\n\n"
+	} else {
+		content += subtitleStyle.Render("Current: "+r.currentRegion) + "\n\n"
+	}
+
+	if len(r.flatRegions) == 0 {
+		if custom := r.CustomRegion(); custom != "" {
+			content += normalStyle.Render("Use custom region: ") + selectedStyle.Render(custom) + "\n"
+		} else {
+			content += subtitleStyle.Render("No regions match") + "\n"
+		}
+	}
 
 	visible := r.visibleCount()
 	end := min(r.offset+visible, len(r.flatRegions))
@@ -211,10 +317,22 @@ func (r *RegionSelector) View() string {
 		}
 
 		line += code + name
+
+		// Measured latency, flagging regions that will feel sluggish
+		if latency, ok := r.latencies[region.Code]; ok {
+			ms := fmt.Sprintf("  %dms", latency.Milliseconds())
+			if latency > highLatencyThreshold {
+				warnStyle := lipgloss.NewStyle().Foreground(theme.Warning)
+				line += warnStyle.Render(ms + " ⚠")
+			} else {
+				line += subtitleStyle.Render(ms)
+			}
+		}
+
 		content += line + "\n"
 	}
 
-	content += "\n" + hintStyle.Render("↑↓ navigate • Enter select • Esc cancel")
+	content += "\n" + hintStyle.Render("type to filter • ↑↓ navigate • Enter select • Esc cancel")
 
 	return lipgloss.Place(
 		r.width,
@@ -12,13 +12,15 @@ import (
 
 // SQSTable displays SQS queues in a simple table format.
 type SQSTable struct {
-	width   int
-	height  int
-	queues  []model.Queue
-	cursor  int
-	loading bool
-	err     error
-	spinner *Spinner
+	width     int
+	height    int
+	queues    []model.Queue
+	cursor    int
+	loading   bool
+	err       error
+	spinner   *Spinner
+	msgDeltas map[string]int // queue name -> message-count delta since last refresh
+	highlight string         // Filter text highlighted within queue names
 }
 
 // NewSQSTable creates a new SQSTable.
@@ -42,6 +44,16 @@ func (t *SQSTable) SetQueues(queues []model.Queue) {
 	}
 }
 
+// SetHighlight sets the filter text to highlight within queue names.
+func (t *SQSTable) SetHighlight(query string) {
+	t.highlight = query
+}
+
+// SetMessageDeltas sets per-queue message-count deltas shown next to the count.
+func (t *SQSTable) SetMessageDeltas(deltas map[string]int) {
+	t.msgDeltas = deltas
+}
+
 // SetLoading sets the loading state.
 func (t *SQSTable) SetLoading(loading bool) {
 	t.loading = loading
@@ -235,10 +247,28 @@ func (t *SQSTable) renderTable() string {
 		// Apply style
 		if isSelected {
 			b.WriteString(selectedStyle.Render(row))
+		} else if t.highlight != "" {
+			// Highlight the matched substring within the name column only;
+			// the name is padded first so alignment is preserved
+			namePadded := fmt.Sprintf("%-*s", nameWidth, name)
+			rest := fmt.Sprintf("  %*d  %*d", msgWidth, q.ApproximateMessageCount, flightWidth, q.ApproximateInFlight)
+			hl := lipgloss.NewStyle().Background(theme.BgHighlight)
+			b.WriteString(cursor + highlightSegments(namePadded, t.highlight, lipgloss.NewStyle(), hl) + rest)
 		} else {
 			b.WriteString(row)
 		}
 
+		// Show message-count delta since the previous refresh
+		if d, ok := t.msgDeltas[q.Name]; ok && d != 0 {
+			arrow := "↑"
+			deltaStyle := lipgloss.NewStyle().Foreground(theme.Warning)
+			if d < 0 {
+				arrow = "↓"
+				deltaStyle = lipgloss.NewStyle().Foreground(theme.Success)
+			}
+			b.WriteString(deltaStyle.Render(fmt.Sprintf("  %s%+d", arrow, d)))
+		}
+
 		if i < endIdx-1 {
 			b.WriteString("\n")
 		}
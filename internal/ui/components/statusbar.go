@@ -3,6 +3,7 @@ package components
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	"vaws/internal/ui/theme"
@@ -14,11 +15,14 @@ import (
 //
 //	vaws v1.1.1  │  ◉ prod-profile  │  us-east-1  │  ⚡3 tunnels  │  ?help  qQuit
 type StatusBar struct {
-	width         int
-	version       string
-	profile       string
-	region        string
-	activeTunnels int
+	width            int
+	version          string
+	profile          string
+	region           string
+	activeTunnels    int
+	endpointOverride string
+	clock            time.Time
+	lastRefresh      time.Time
 }
 
 // NewStatusBar creates a new StatusBar component.
@@ -53,6 +57,33 @@ func (s *StatusBar) SetActiveTunnels(count int) {
 	s.activeTunnels = count
 }
 
+// SetEndpointOverride sets the custom endpoint URL shown as an override badge.
+func (s *StatusBar) SetEndpointOverride(url string) {
+	s.endpointOverride = url
+}
+
+// SetClock sets the wall-clock time shown on the right.
+func (s *StatusBar) SetClock(t time.Time) {
+	s.clock = t
+}
+
+// SetLastRefresh records when the active view's data last loaded.
+func (s *StatusBar) SetLastRefresh(t time.Time) {
+	s.lastRefresh = t
+}
+
+// refreshAge renders a compact "12s ago" / "3m ago" age.
+func refreshAge(d time.Duration) string {
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds ago", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm ago", int(d.Minutes()))
+	default:
+		return fmt.Sprintf("%dh ago", int(d.Hours()))
+	}
+}
+
 // View renders the status bar.
 func (s *StatusBar) View() string {
 	// Styles
@@ -107,24 +138,50 @@ func (s *StatusBar) View() string {
 		middleParts = append(middleParts, tunnelStyle.Render(tunnelText))
 	}
 
+	// Endpoint override badge - make it obvious this isn't real AWS
+	if s.endpointOverride != "" {
+		overrideStyle := lipgloss.NewStyle().
+			Foreground(theme.Warning).
+			Bold(true)
+		middleParts = append(middleParts, overrideStyle.Render("⚠ endpoint override"))
+	}
+
 	middle := strings.Join(middleParts, separator)
 
-	// Build right side: shortcuts
-	right := keyStyle.Render("?help") + "  " + keyStyle.Render("q quit")
+	// Build right side: last refresh + clock + shortcuts, dropping the
+	// extras first when the terminal is too narrow for them
+	shortcuts := keyStyle.Render("?help") + "  " + keyStyle.Render("q quit")
+	clockStyle := lipgloss.NewStyle().Foreground(theme.TextMuted)
+	refreshStyle := lipgloss.NewStyle().Foreground(theme.TextDim)
 
-	// Calculate spacing
+	// Add separators to left
 	leftWidth := lipgloss.Width(left)
 	middleWidth := lipgloss.Width(middle)
-	rightWidth := lipgloss.Width(right)
-
-	// Add separators to left
 	if middle != "" {
 		left = left + separator
 		leftWidth = lipgloss.Width(left)
 	}
 
+	var right string
+	for _, extras := range [][]string{{"refresh", "clock"}, {"clock"}, {}} {
+		parts := []string{}
+		for _, extra := range extras {
+			switch {
+			case extra == "refresh" && !s.lastRefresh.IsZero():
+				parts = append(parts, refreshStyle.Render("↻ "+refreshAge(time.Since(s.lastRefresh))))
+			case extra == "clock" && !s.clock.IsZero():
+				parts = append(parts, clockStyle.Render(s.clock.Format("15:04:05")))
+			}
+		}
+		parts = append(parts, shortcuts)
+		right = strings.Join(parts, separator)
+		if leftWidth+middleWidth+lipgloss.Width(right)+4 <= s.width {
+			break
+		}
+	}
+
 	// Calculate gap between middle and right
-	totalUsed := leftWidth + middleWidth + rightWidth
+	totalUsed := leftWidth + middleWidth + lipgloss.Width(right)
 	gap := s.width - totalUsed - 2 // -2 for padding
 	if gap < 2 {
 		gap = 2
@@ -0,0 +1,336 @@
+package components
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"gopkg.in/yaml.v3"
+
+	"vaws/internal/ui/theme"
+)
+
+// EditorFormat selects the validation mode of the structured editor.
+type EditorFormat int
+
+const (
+	FormatJSON EditorFormat = iota
+	FormatYAML
+	FormatText // free-form, no validation (e.g. SQS message bodies)
+)
+
+// EditorResult is returned when the user submits or cancels the editor.
+type EditorResult struct {
+	Value     string
+	Cancelled bool
+}
+
+// StructuredEditor is a reusable multi-line editor for JSON/YAML payloads
+// with live validation, parse-error positions, basic auto-indent, and a
+// format (re-indent) action. Every data-entry feature that edits structured
+// data shares this component.
+type StructuredEditor struct {
+	textarea    textarea.Model
+	format      EditorFormat
+	allowToggle bool // whether ctrl+t may switch between JSON and YAML
+	title       string
+	active      bool
+	width       int
+	height      int
+}
+
+// NewStructuredEditor creates a new editor validating JSON by default.
+func NewStructuredEditor() *StructuredEditor {
+	ta := textarea.New()
+	ta.Placeholder = "{}"
+	ta.ShowLineNumbers = true
+	ta.CharLimit = 0 // unlimited
+
+	return &StructuredEditor{
+		textarea:    ta,
+		format:      FormatJSON,
+		allowToggle: true,
+	}
+}
+
+// Activate opens the editor with a title and initial content.
+func (e *StructuredEditor) Activate(title, initial string) tea.Cmd {
+	e.title = title
+	e.active = true
+	e.textarea.SetValue(initial)
+	e.textarea.Focus()
+	return textarea.Blink
+}
+
+// Deactivate closes the editor.
+func (e *StructuredEditor) Deactivate() {
+	e.active = false
+	e.textarea.Blur()
+}
+
+// IsActive returns whether the editor is open.
+func (e *StructuredEditor) IsActive() bool {
+	return e.active
+}
+
+// SetFormat sets the validation format and whether toggling is allowed.
+func (e *StructuredEditor) SetFormat(format EditorFormat, allowToggle bool) {
+	e.format = format
+	e.allowToggle = allowToggle
+}
+
+// SetSize sets the editor dimensions.
+func (e *StructuredEditor) SetSize(width, height int) {
+	e.width = width
+	e.height = height
+
+	taWidth := width - 8
+	if taWidth < 20 {
+		taWidth = 20
+	}
+	taHeight := height - 8
+	if taHeight < 3 {
+		taHeight = 3
+	}
+	if taHeight > 20 {
+		taHeight = 20
+	}
+	e.textarea.SetWidth(taWidth)
+	e.textarea.SetHeight(taHeight)
+}
+
+// Value returns the current buffer contents.
+func (e *StructuredEditor) Value() string {
+	return e.textarea.Value()
+}
+
+// Update handles key input. A non-nil result means the editor finished.
+func (e *StructuredEditor) Update(msg tea.Msg) (*EditorResult, tea.Cmd) {
+	if !e.active {
+		return nil, nil
+	}
+
+	if key, ok := msg.(tea.KeyMsg); ok {
+		switch key.String() {
+		case "esc":
+			e.Deactivate()
+			return &EditorResult{Cancelled: true}, nil
+
+		case "ctrl+s":
+			// Submit only when the buffer validates
+			if _, _, err := e.validate(); err != nil {
+				return nil, nil
+			}
+			value := e.textarea.Value()
+			e.Deactivate()
+			return &EditorResult{Value: value}, nil
+
+		case "ctrl+f":
+			e.formatBuffer()
+			return nil, nil
+
+		case "ctrl+t":
+			if e.allowToggle {
+				e.toggleFormat()
+			}
+			return nil, nil
+
+		case "enter":
+			// Basic auto-indent: carry the current line's leading whitespace
+			indent := e.currentLineIndent()
+			var cmd tea.Cmd
+			e.textarea, cmd = e.textarea.Update(msg)
+			if indent != "" {
+				e.textarea.InsertString(indent)
+			}
+			return nil, cmd
+		}
+	}
+
+	var cmd tea.Cmd
+	e.textarea, cmd = e.textarea.Update(msg)
+	return nil, cmd
+}
+
+// currentLineIndent returns the leading whitespace of the cursor's line.
+func (e *StructuredEditor) currentLineIndent() string {
+	lines := strings.Split(e.textarea.Value(), "\n")
+	ln := e.textarea.Line()
+	if ln < 0 || ln >= len(lines) {
+		return ""
+	}
+	var indent strings.Builder
+	for _, r := range lines[ln] {
+		if r != ' ' && r != '\t' {
+			break
+		}
+		indent.WriteRune(r)
+	}
+	return indent.String()
+}
+
+// validate parses the buffer in the active format, returning the error
+// position (1-based, JSON only) when it fails. An empty buffer is valid.
+func (e *StructuredEditor) validate() (line, col int, err error) {
+	value := e.textarea.Value()
+	if e.format == FormatText || strings.TrimSpace(value) == "" {
+		return 0, 0, nil
+	}
+
+	if e.format == FormatYAML {
+		var out interface{}
+		// yaml errors already include the line number in their message
+		return 0, 0, yaml.Unmarshal([]byte(value), &out)
+	}
+
+	var out interface{}
+	err = json.Unmarshal([]byte(value), &out)
+	if err == nil {
+		return 0, 0, nil
+	}
+	if syn, ok := err.(*json.SyntaxError); ok {
+		line, col = offsetToPosition(value, int(syn.Offset))
+	}
+	return line, col, err
+}
+
+// offsetToPosition converts a byte offset into a 1-based line/column pair.
+func offsetToPosition(s string, offset int) (line, col int) {
+	line, col = 1, 1
+	for i := 0; i < offset-1 && i < len(s); i++ {
+		if s[i] == '\n' {
+			line++
+			col = 1
+		} else {
+			col++
+		}
+	}
+	return line, col
+}
+
+// formatBuffer re-indents the buffer when it parses cleanly.
+func (e *StructuredEditor) formatBuffer() {
+	value := e.textarea.Value()
+	if e.format == FormatText || strings.TrimSpace(value) == "" {
+		return
+	}
+
+	if e.format == FormatYAML {
+		var out interface{}
+		if err := yaml.Unmarshal([]byte(value), &out); err != nil {
+			return
+		}
+		formatted, err := yaml.Marshal(out)
+		if err != nil {
+			return
+		}
+		e.textarea.SetValue(strings.TrimRight(string(formatted), "\n"))
+		return
+	}
+
+	var out interface{}
+	if err := json.Unmarshal([]byte(value), &out); err != nil {
+		return
+	}
+	formatted, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return
+	}
+	e.textarea.SetValue(string(formatted))
+}
+
+// toggleFormat converts the buffer between JSON and YAML when it parses.
+func (e *StructuredEditor) toggleFormat() {
+	value := e.textarea.Value()
+
+	if e.format == FormatJSON {
+		var out interface{}
+		if strings.TrimSpace(value) != "" {
+			if err := json.Unmarshal([]byte(value), &out); err != nil {
+				return // don't switch modes on an invalid buffer
+			}
+			if converted, err := yaml.Marshal(out); err == nil {
+				e.textarea.SetValue(strings.TrimRight(string(converted), "\n"))
+			}
+		}
+		e.format = FormatYAML
+		return
+	}
+
+	var out interface{}
+	if strings.TrimSpace(value) != "" {
+		if err := yaml.Unmarshal([]byte(value), &out); err != nil {
+			return
+		}
+		if converted, err := json.MarshalIndent(out, "", "  "); err == nil {
+			e.textarea.SetValue(string(converted))
+		}
+	}
+	e.format = FormatJSON
+}
+
+// formatName returns the active format's display name.
+func (e *StructuredEditor) formatName() string {
+	switch e.format {
+	case FormatYAML:
+		return "YAML"
+	case FormatText:
+		return "text"
+	default:
+		return "JSON"
+	}
+}
+
+// View renders the editor with its live validation status line.
+func (e *StructuredEditor) View() string {
+	if !e.active {
+		return ""
+	}
+
+	dialogWidth := e.width - 4
+	if dialogWidth > 80 {
+		dialogWidth = 80
+	}
+	if dialogWidth < 30 {
+		dialogWidth = 30
+	}
+
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.BorderFocus).
+		Padding(1, 2).
+		Width(dialogWidth)
+
+	titleStyle := lipgloss.NewStyle().Foreground(theme.Primary).Bold(true)
+	okStyle := lipgloss.NewStyle().Foreground(theme.Success)
+	errStyle := lipgloss.NewStyle().Foreground(theme.Error)
+	hintStyle := lipgloss.NewStyle().Foreground(theme.TextDim).Italic(true)
+
+	var status string
+	if e.format == FormatText {
+		status = hintStyle.Render("free-form text")
+	} else if line, col, err := e.validate(); err != nil {
+		if line > 0 {
+			status = errStyle.Render(fmt.Sprintf("✗ line %d, col %d: %v", line, col, err))
+		} else {
+			status = errStyle.Render("✗ " + err.Error())
+		}
+	} else {
+		status = okStyle.Render("✓ valid " + e.formatName())
+	}
+
+	hints := "Ctrl+S submit • Ctrl+F format • Esc cancel"
+	if e.allowToggle {
+		hints = "Ctrl+S submit • Ctrl+F format • Ctrl+T " + e.formatName() + "/toggle • Esc cancel"
+	}
+
+	content := titleStyle.Render(e.title) + "\n\n" +
+		e.textarea.View() + "\n\n" +
+		status + "\n" +
+		hintStyle.Render(hints)
+
+	return dialogStyle.Render(content)
+}
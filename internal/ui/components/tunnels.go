@@ -148,6 +148,9 @@ func (t *TunnelsPanel) View() string {
 		case model.TunnelStatusStarting:
 			statusIcon = "◐"
 			statusStyle = tunnelStartingStyle
+		case model.TunnelStatusReconnecting:
+			statusIcon = "↻"
+			statusStyle = tunnelStartingStyle
 		case model.TunnelStatusError:
 			statusIcon = "✗"
 			statusStyle = tunnelErrorStyle
@@ -183,10 +186,12 @@ func (t *TunnelsPanel) View() string {
 		// Service name
 		line.WriteString(tunnelServiceStyle.Render(tun.ServiceName))
 
-		// Duration
+		// Duration and relay traffic counters
 		if tun.Status == model.TunnelStatusActive {
 			duration := time.Since(tun.StartedAt).Truncate(time.Second)
 			line.WriteString(s.Muted.Render(fmt.Sprintf("  (%s)", duration)))
+			line.WriteString(s.Muted.Render(fmt.Sprintf("  ↓%s ↑%s %d conn",
+				formatSize(tun.BytesIn), formatSize(tun.BytesOut), tun.ActiveConns)))
 		}
 
 		// Error message
@@ -225,6 +230,9 @@ func (t *TunnelsPanel) View() string {
 		case model.TunnelStatusStarting:
 			statusIcon = "◐"
 			statusStyle = tunnelStartingStyle
+		case model.TunnelStatusReconnecting:
+			statusIcon = "↻"
+			statusStyle = tunnelStartingStyle
 		case model.TunnelStatusError:
 			statusIcon = "✗"
 			statusStyle = tunnelErrorStyle
@@ -1,6 +1,42 @@
 package components
 
-import "github.com/charmbracelet/lipgloss"
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// highlightSegments renders s with case-insensitive occurrences of query in
+// the highlight style and everything else in the base style. s must already
+// be truncated and padded: styling adds no visible width, so column
+// alignment is preserved.
+func highlightSegments(s, query string, base, highlight lipgloss.Style) string {
+	if query == "" {
+		return base.Render(s)
+	}
+
+	lower := strings.ToLower(s)
+	q := strings.ToLower(query)
+
+	var b strings.Builder
+	i := 0
+	for {
+		idx := strings.Index(lower[i:], q)
+		if idx < 0 {
+			if i < len(s) {
+				b.WriteString(base.Render(s[i:]))
+			}
+			break
+		}
+		start := i + idx
+		if start > i {
+			b.WriteString(base.Render(s[i:start]))
+		}
+		b.WriteString(highlight.Render(s[start : start+len(q)]))
+		i = start + len(q)
+	}
+	return b.String()
+}
 
 // truncate truncates a string to the specified maximum width.
 func truncate(s string, maxLen int) string {
@@ -0,0 +1,266 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"vaws/internal/aws"
+	"vaws/internal/tunnel"
+	"vaws/internal/ui/components"
+	"vaws/internal/ui/theme"
+)
+
+// Context switcher stages: pick a profile, pick a region, confirm after the
+// resolved account is shown.
+const (
+	ctxStageProfile = iota
+	ctxStageRegion
+	ctxStageConfirm
+)
+
+// openContextSwitch opens the combined profile/region switcher overlay.
+func (m *Model) openContextSwitch() tea.Cmd {
+	profiles, err := aws.ListProfiles()
+	if err != nil || len(profiles) == 0 {
+		profiles = []string{"default"}
+	}
+
+	m.ctxSwitchActive = true
+	m.ctxSwitchStage = ctxStageProfile
+	m.ctxSwitchProfiles = profiles
+	m.ctxSwitchRegions = nil
+	for _, group := range components.AWSRegions {
+		m.ctxSwitchRegions = append(m.ctxSwitchRegions, group.Regions...)
+	}
+	m.ctxSwitchCursor = 0
+	for i, p := range profiles {
+		if p == m.state.Profile {
+			m.ctxSwitchCursor = i
+			break
+		}
+	}
+	m.ctxSwitchProfile = ""
+	m.ctxSwitchRegion = ""
+	m.ctxSwitchAccount = ""
+	m.ctxSwitchClient = nil
+	m.ctxSwitchErr = nil
+	m.ctxSwitchLoading = false
+	return nil
+}
+
+// closeContextSwitch dismisses the overlay without switching.
+func (m *Model) closeContextSwitch() {
+	m.ctxSwitchActive = false
+	m.ctxSwitchClient = nil
+	m.ctxSwitchLoading = false
+	m.ctxSwitchErr = nil
+}
+
+// handleContextSwitchKey handles keys while the switcher overlay is open.
+func (m *Model) handleContextSwitchKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		m.closeContextSwitch()
+		return nil
+
+	case "up", "k":
+		if m.ctxSwitchCursor > 0 {
+			m.ctxSwitchCursor--
+		}
+		return nil
+
+	case "down", "j":
+		if m.ctxSwitchCursor < m.ctxSwitchListLen()-1 {
+			m.ctxSwitchCursor++
+		}
+		return nil
+
+	case "enter":
+		switch m.ctxSwitchStage {
+		case ctxStageProfile:
+			m.ctxSwitchProfile = m.ctxSwitchProfiles[m.ctxSwitchCursor]
+			m.ctxSwitchStage = ctxStageRegion
+			m.ctxSwitchCursor = 0
+			for i, r := range m.ctxSwitchRegions {
+				if r.Code == m.state.Region {
+					m.ctxSwitchCursor = i
+					break
+				}
+			}
+			return nil
+
+		case ctxStageRegion:
+			m.ctxSwitchRegion = m.ctxSwitchRegions[m.ctxSwitchCursor].Code
+			m.ctxSwitchStage = ctxStageConfirm
+			m.ctxSwitchLoading = true
+			// Build the candidate client once and resolve its account so
+			// the user sees where they're going before committing.
+			profile, region := m.ctxSwitchProfile, m.ctxSwitchRegion
+			return func() tea.Msg {
+				ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+				defer cancel()
+
+				client, err := aws.NewClient(ctx, profile, region)
+				if err != nil {
+					return ctxSwitchPreviewMsg{err: err}
+				}
+				account, err := client.AccountID(ctx)
+				return ctxSwitchPreviewMsg{client: client, account: account, err: err}
+			}
+
+		case ctxStageConfirm:
+			return m.commitContextSwitch()
+		}
+	}
+
+	return nil
+}
+
+// ctxSwitchListLen returns the length of the list the cursor moves over.
+func (m *Model) ctxSwitchListLen() int {
+	if m.ctxSwitchStage == ctxStageProfile {
+		return len(m.ctxSwitchProfiles)
+	}
+	return len(m.ctxSwitchRegions)
+}
+
+// commitContextSwitch swaps in the previewed client, clearing cached data
+// exactly once for the combined profile+region change.
+func (m *Model) commitContextSwitch() tea.Cmd {
+	client := m.ctxSwitchClient
+	account := m.ctxSwitchAccount
+	if client == nil {
+		return nil
+	}
+	m.closeContextSwitch()
+
+	m.client = client
+	m.state.Profile = client.Profile()
+	m.state.Region = client.Region()
+	m.tunnelManager = tunnel.NewManager(client.Profile(), client.Region())
+	m.applyTunnelConfig()
+	m.apiGWManager = tunnel.NewAPIGatewayManager(client.Profile(), client.Region())
+
+	// Clear all cached data once for the combined change
+	m.cancelLazyLoads()
+	m.state.ClearAllData()
+	m.stackCounts = nil
+	m.resourceAlarms = nil
+	m.exactCounts = nil
+	m.queueMetrics = nil
+	m.fnMappings = nil
+	m.svcEvents = nil
+	m.svcTaskDefs = nil
+	m.resourceTags = nil
+	m.fnURLs = nil
+	m.stackTree = nil
+	m.stackEvents = nil
+	m.stackDrift = nil
+	m.stackInfo = nil
+	m.loadGeneration++
+
+	m.logger.Info("Switched to %s @ %s (account %s)", client.Profile(), client.Region(), account)
+	m.updateCurrentList()
+	return m.handleRefresh()
+}
+
+// renderContextSwitchDialog renders the profile/region switcher overlay.
+func (m *Model) renderContextSwitchDialog() string {
+	dialogWidth := 54
+	if m.width < 64 {
+		dialogWidth = m.width - 10
+		if dialogWidth < 30 {
+			dialogWidth = 30
+		}
+	}
+
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.BorderFocus).
+		Padding(1, 2).
+		Width(dialogWidth)
+
+	titleStyle := lipgloss.NewStyle().Foreground(theme.Primary).Bold(true)
+	selectedStyle := lipgloss.NewStyle().Foreground(theme.Primary).Bold(true)
+	normalStyle := lipgloss.NewStyle().Foreground(theme.Text)
+	dimStyle := lipgloss.NewStyle().Foreground(theme.TextDim)
+	hintStyle := lipgloss.NewStyle().Foreground(theme.TextDim).Italic(true)
+
+	content := titleStyle.Render("Switch Context") + "\n"
+	content += dimStyle.Render(fmt.Sprintf("Current: %s @ %s", m.state.Profile, m.state.Region)) + "\n\n"
+
+	switch m.ctxSwitchStage {
+	case ctxStageProfile:
+		content += dimStyle.Render("Profile:") + "\n"
+		for _, line := range ctxSwitchWindow(m.ctxSwitchProfiles, m.ctxSwitchCursor, 8) {
+			if line.index == m.ctxSwitchCursor {
+				content += selectedStyle.Render("▸ "+line.text) + "\n"
+			} else {
+				content += normalStyle.Render("  "+line.text) + "\n"
+			}
+		}
+		content += "\n" + hintStyle.Render("↑↓ select profile • Enter next • Esc cancel")
+
+	case ctxStageRegion:
+		content += dimStyle.Render("Profile: "+m.ctxSwitchProfile) + "\n"
+		content += dimStyle.Render("Region:") + "\n"
+		codes := make([]string, len(m.ctxSwitchRegions))
+		for i, r := range m.ctxSwitchRegions {
+			codes[i] = fmt.Sprintf("%-16s %s", r.Code, r.Name)
+		}
+		for _, line := range ctxSwitchWindow(codes, m.ctxSwitchCursor, 8) {
+			if line.index == m.ctxSwitchCursor {
+				content += selectedStyle.Render("▸ "+line.text) + "\n"
+			} else {
+				content += normalStyle.Render("  "+line.text) + "\n"
+			}
+		}
+		content += "\n" + hintStyle.Render("↑↓ select region • Enter preview • Esc cancel")
+
+	case ctxStageConfirm:
+		content += normalStyle.Render("Profile: "+m.ctxSwitchProfile) + "\n"
+		content += normalStyle.Render("Region:  "+m.ctxSwitchRegion) + "\n"
+		switch {
+		case m.ctxSwitchLoading:
+			content += dimStyle.Render("Account: resolving...") + "\n\n"
+			content += hintStyle.Render("Esc cancel")
+		case m.ctxSwitchErr != nil:
+			errStyle := lipgloss.NewStyle().Foreground(theme.Error)
+			content += errStyle.Render("Error: "+m.ctxSwitchErr.Error()) + "\n\n"
+			content += hintStyle.Render("Esc cancel")
+		default:
+			content += normalStyle.Render("Account: "+m.ctxSwitchAccount) + "\n\n"
+			content += hintStyle.Render("Enter switch • Esc cancel")
+		}
+	}
+
+	return dialogStyle.Render(content)
+}
+
+// ctxSwitchLine pairs a rendered line with its index in the full list.
+type ctxSwitchLine struct {
+	index int
+	text  string
+}
+
+// ctxSwitchWindow returns a window of up to size lines centered on cursor.
+func ctxSwitchWindow(items []string, cursor, size int) []ctxSwitchLine {
+	start := 0
+	if cursor >= size {
+		start = cursor - size + 1
+	}
+	end := start + size
+	if end > len(items) {
+		end = len(items)
+	}
+
+	lines := make([]ctxSwitchLine, 0, end-start)
+	for i := start; i < end; i++ {
+		lines = append(lines, ctxSwitchLine{index: i, text: items[i]})
+	}
+	return lines
+}
@@ -2,11 +2,13 @@ package ui
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/charmbracelet/lipgloss"
 
+	"vaws/internal/model"
 	"vaws/internal/ui/components"
 	"vaws/internal/ui/theme"
 )
@@ -30,6 +32,13 @@ func (m *Model) updateStackDetails() {
 				s.Description,
 				StatusStyle(string(s.Status)),
 			)
+
+			// Outputs and parameters (fetched with 'O')
+			rows = append(rows, m.stackInfoDetailRows(s.Name)...)
+
+			// Drift status and event history (fetched with 'V' and 'v')
+			rows = append(rows, m.stackEventDetailRows(s.Name)...)
+
 			m.details.SetTitle("Stack Details")
 			m.details.SetRows(rows)
 			return
@@ -74,6 +83,29 @@ func (m *Model) updateServiceDetails() {
 				containerPortsStr,
 				ServiceStatusStyle(s.RunningCount, s.DesiredCount),
 			)
+
+			// Actionable diagnosis for known ECS placement/capacity failures
+			if diagnosis := model.DiagnoseServiceEvents(s.Events); diagnosis != "" {
+				rows = append(rows, components.DetailRow{Label: "", Value: ""}) // Spacer
+				rows = append(rows, components.DetailRow{
+					Label: "Diagnosis",
+					Value: diagnosis,
+					Style: lipgloss.NewStyle().Foreground(theme.Warning),
+				})
+			}
+			if len(s.Events) > 0 {
+				rows = append(rows, components.DetailRow{
+					Label: "Last Event",
+					Value: s.Events[0].Message,
+				})
+			}
+
+			// Active task definition fetched on demand with 'D'
+			rows = append(rows, m.taskDefDetailRows(&s)...)
+
+			// Full event log fetched on demand with 'v'
+			rows = append(rows, m.svcEventDetailRows(s.Name)...)
+
 			m.details.SetTitle("Service Details")
 			m.details.SetRows(rows)
 			return
@@ -105,6 +137,36 @@ func (m *Model) updateLambdaDetails() {
 				{Label: "Description", Value: fn.Description},
 			}
 
+			// Event source mappings (fetched with 'M')
+			rows = append(rows, m.mappingDetailRows(fn.Name)...)
+
+			// Environment variables, masking secret-looking values ('u' toggles)
+			rows = append(rows, m.envDetailRows(fn.Environment)...)
+
+			// Cost-allocation tags (from describe, or refreshed by 'T')
+			tags := fn.Tags
+			if cached, ok := m.resourceTags[tagKindFunction+":"+fn.ARN]; ok {
+				tags = cached
+			}
+			rows = append(rows, tagRows(tags)...)
+
+			// Function URL config (fetched with 'U')
+			rows = append(rows, m.fnURLDetailRows(fn.Name)...)
+
+			// VPC attachment details for networking audits
+			if fn.InVPC() {
+				rows = append(rows, components.DetailRow{Label: "", Value: ""}) // Spacer
+				rows = append(rows, components.DetailRow{Label: "VPC", Value: fn.VpcConfig.VpcID})
+				rows = append(rows, components.DetailRow{
+					Label: "Subnets",
+					Value: fmt.Sprintf("%d (%s)", len(fn.VpcConfig.SubnetIDs), strings.Join(fn.VpcConfig.SubnetIDs, ", ")),
+				})
+				rows = append(rows, components.DetailRow{
+					Label: "Security Groups",
+					Value: fmt.Sprintf("%d (%s)", len(fn.VpcConfig.SecurityGroupIDs), strings.Join(fn.VpcConfig.SecurityGroupIDs, ", ")),
+				})
+			}
+
 			// Add invocation state if available
 			if m.state.LambdaInvocationLoading {
 				rows = append(rows, components.DetailRow{Label: "", Value: ""}) // Spacer
@@ -152,8 +214,25 @@ func (m *Model) updateLambdaDetails() {
 					Label: "Response",
 					Value: response,
 				})
+
+				// Paths for file-payload invocations
+				if result.ResponsePath != "" {
+					rows = append(rows, components.DetailRow{
+						Label: "Response File",
+						Value: result.ResponsePath,
+					})
+				}
+				if result.LogsPath != "" {
+					rows = append(rows, components.DetailRow{
+						Label: "Log File",
+						Value: result.LogsPath,
+					})
+				}
 			}
 
+			// CloudWatch alarms on Errors/Throttles (fetched with 'A')
+			rows = append(rows, m.alarmDetailRows("lambda:"+fn.Name)...)
+
 			m.details.SetTitle("Lambda Function Details")
 			m.details.SetRows(rows)
 			return
@@ -238,6 +317,20 @@ func (m *Model) updateAPIStageDetails() {
 				{Label: "Last Updated", Value: stage.LastUpdated.Format("2006-01-02 15:04:05")},
 				{Label: "Description", Value: stage.Description},
 			}
+
+			// Stage variables ('V' sets one)
+			if len(stage.Variables) > 0 {
+				rows = append(rows, components.DetailRow{Label: "", Value: ""}) // Spacer
+				rows = append(rows, components.DetailRow{Label: "Variables", Value: fmt.Sprintf("%d", len(stage.Variables))})
+				keys := make([]string, 0, len(stage.Variables))
+				for k := range stage.Variables {
+					keys = append(keys, k)
+				}
+				sort.Strings(keys)
+				for _, k := range keys {
+					rows = append(rows, components.DetailRow{Label: "  " + truncateString(k, 14), Value: stage.Variables[k]})
+				}
+			}
 			m.details.SetTitle("API Stage Details")
 			m.details.SetRows(rows)
 			return
@@ -245,6 +338,48 @@ func (m *Model) updateAPIStageDetails() {
 	}
 }
 
+// updateJumpHostDetails shows the selected EC2 instance, including its tags
+// so tag filters have visible targets.
+func (m *Model) updateJumpHostDetails() {
+	item := m.ec2List.SelectedItem()
+	if item == nil {
+		m.details.SetTitle("Instance Details")
+		m.details.SetRows(nil)
+		return
+	}
+
+	for _, inst := range m.state.EC2Instances {
+		if inst.InstanceID != item.ID {
+			continue
+		}
+
+		rows := []components.DetailRow{
+			{Label: "Name", Value: inst.Name},
+			{Label: "Instance", Value: inst.InstanceID},
+			{Label: "Type", Value: inst.InstanceType},
+			{Label: "State", Value: inst.State},
+			{Label: "Private IP", Value: inst.PrivateIPAddress},
+			{Label: "VPC", Value: inst.VpcID},
+		}
+		if len(inst.Tags) > 0 {
+			rows = append(rows, components.DetailRow{Label: "", Value: ""}) // Spacer
+			rows = append(rows, components.DetailRow{Label: "Tags", Value: fmt.Sprintf("%d", len(inst.Tags))})
+			keys := make([]string, 0, len(inst.Tags))
+			for k := range inst.Tags {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				rows = append(rows, components.DetailRow{Label: "  " + truncateString(k, 14), Value: inst.Tags[k]})
+			}
+		}
+
+		m.details.SetTitle("Instance Details")
+		m.details.SetRows(rows)
+		return
+	}
+}
+
 // updateQueueDetails updates the details panel with SQS queue information.
 func (m *Model) updateQueueDetails() {
 	q := m.sqsTable.SelectedQueue()
@@ -258,8 +393,8 @@ func (m *Model) updateQueueDetails() {
 		{Label: "Name", Value: q.Name},
 		{Label: "Type", Value: string(q.Type)},
 		{Label: "", Value: ""}, // Spacer
-		{Label: "Messages", Value: fmt.Sprintf("%d", q.ApproximateMessageCount)},
-		{Label: "In Flight", Value: fmt.Sprintf("%d", q.ApproximateInFlight)},
+		{Label: "Messages (approx)", Value: fmt.Sprintf("%d", q.ApproximateMessageCount)},
+		{Label: "In Flight (approx)", Value: fmt.Sprintf("%d", q.ApproximateInFlight)},
 		{Label: "", Value: ""}, // Spacer
 		{Label: "Visibility", Value: fmt.Sprintf("%ds", q.VisibilityTimeout)},
 		{Label: "Retention", Value: formatDuration(q.MessageRetentionPeriod)},
@@ -270,6 +405,15 @@ func (m *Model) updateQueueDetails() {
 		rows = append(rows, components.DetailRow{Label: "Created", Value: q.CreatedAt.Format("2006-01-02")})
 	}
 
+	// Queue depth over the last hour, so growth vs drain is visible at a glance
+	if entry, ok := m.queueMetrics[q.Name]; ok && len(entry.points) > 0 {
+		rows = append(rows, components.DetailRow{
+			Label: "Depth (1h)",
+			Value: sparkline(entry.points, 30),
+			Style: lipgloss.NewStyle().Foreground(theme.Info),
+		})
+	}
+
 	// Add DLQ info if present
 	if q.HasDLQ {
 		rows = append(rows, components.DetailRow{Label: "", Value: ""}) // Spacer
@@ -282,6 +426,51 @@ func (m *Model) updateQueueDetails() {
 		rows = append(rows, components.DetailRow{Label: "Max Receives", Value: fmt.Sprintf("%d", q.MaxReceiveCount)})
 	}
 
+	// Access policy summary - which principals/actions this queue grants,
+	// flagging statements open to anyone
+	if q.Policy != "" {
+		statements := model.ParseQueuePolicy(q.Policy)
+		rows = append(rows, components.DetailRow{Label: "", Value: ""}) // Spacer
+		rows = append(rows, components.DetailRow{Label: "Policy", Value: fmt.Sprintf("%d statement(s)", len(statements))})
+		for i, st := range statements {
+			rows = append(rows, components.DetailRow{
+				Label: fmt.Sprintf("  Stmt %d", i+1),
+				Value: fmt.Sprintf("%s %s to %s", st.Effect, strings.Join(st.Actions, ","), strings.Join(st.Principals, ",")),
+			})
+			if st.IsOverlyBroad() {
+				rows = append(rows, components.DetailRow{
+					Label: "  ⚠",
+					Value: `Principal "*" without condition - open to anyone`,
+					Style: lipgloss.NewStyle().Foreground(theme.Error),
+				})
+			}
+		}
+	}
+
+	// Local send tracking - SQS counts are eventually consistent, so a send
+	// that hasn't shown up yet shouldn't look like a failure
+	if sends := m.state.QueueSends[q.Name]; sends > 0 {
+		rows = append(rows, components.DetailRow{Label: "", Value: ""}) // Spacer
+		rows = append(rows, components.DetailRow{
+			Label: "Sent (session)",
+			Value: fmt.Sprintf("%d", sends),
+			Style: lipgloss.NewStyle().Foreground(theme.Success),
+		})
+		if q.ApproximateMessageCount < m.state.QueueSendBaseline[q.Name]+sends {
+			rows = append(rows, components.DetailRow{
+				Label: "Note",
+				Value: "counts are approximate and may lag behind sends",
+				Style: lipgloss.NewStyle().Foreground(theme.TextDim),
+			})
+		}
+	}
+
+	// CloudWatch alarms on the queue depth metric (fetched with 'A')
+	rows = append(rows, m.alarmDetailRows("sqs:"+q.Name)...)
+
+	// Cost-allocation tags (fetched with 'T')
+	rows = append(rows, m.tagDetailRows(tagKindQueue, q.URL)...)
+
 	rows = append(rows, components.DetailRow{Label: "", Value: ""}) // Spacer
 	rows = append(rows, components.DetailRow{Label: "URL", Value: q.URL})
 	rows = append(rows, components.DetailRow{Label: "ARN", Value: q.ARN})
@@ -326,8 +515,16 @@ func (m *Model) updateTableDetails() {
 
 	rows = append(rows, components.DetailRow{Label: "", Value: ""}) // Spacer
 
-	// Stats
-	rows = append(rows, components.DetailRow{Label: "Items", Value: fmt.Sprintf("%d", t.ItemCount)})
+	// Stats - the DescribeTable figure is approximate (updated ~6 hourly);
+	// '#' runs an on-demand COUNT scan for the exact number
+	rows = append(rows, components.DetailRow{Label: "Items (approx)", Value: fmt.Sprintf("%d", t.ItemCount)})
+	if exact, ok := m.exactCounts[t.Name]; ok {
+		rows = append(rows, components.DetailRow{
+			Label: "Items (exact)",
+			Value: fmt.Sprintf("%d", exact),
+			Style: lipgloss.NewStyle().Foreground(theme.Success),
+		})
+	}
 	rows = append(rows, components.DetailRow{Label: "Size", Value: formatBytes(t.SizeBytes)})
 
 	// Indexes
@@ -335,7 +532,11 @@ func (m *Model) updateTableDetails() {
 		rows = append(rows, components.DetailRow{Label: "", Value: ""}) // Spacer
 		rows = append(rows, components.DetailRow{Label: "GSIs", Value: fmt.Sprintf("%d", len(t.GlobalSecondaryIndexes))})
 		for _, gsi := range t.GlobalSecondaryIndexes {
-			rows = append(rows, components.DetailRow{Label: "  " + gsi.IndexName, Value: gsi.Status})
+			value := gsi.Status
+			if gsi.Projection != "" {
+				value += " (" + gsi.Projection + ")"
+			}
+			rows = append(rows, components.DetailRow{Label: "  " + gsi.IndexName, Value: value})
 		}
 	}
 	if len(t.LocalSecondaryIndexes) > 0 {
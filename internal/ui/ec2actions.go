@@ -0,0 +1,180 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"vaws/internal/ui/theme"
+)
+
+// ec2Actions are the lifecycle actions offered on an instance.
+var ec2Actions = []string{"reboot", "stop", "start"}
+
+// ec2PollInterval paces state polls after a lifecycle action.
+const ec2PollInterval = 3 * time.Second
+
+// ec2MaxPolls bounds how long a transitioning instance is watched.
+const ec2MaxPolls = 40
+
+// ec2PollTickMsg schedules the next instance state poll.
+type ec2PollTickMsg time.Time
+
+// startEC2Action opens the lifecycle action picker for the selected instance.
+func (m *Model) startEC2Action() tea.Cmd {
+	item := m.ec2List.SelectedItem()
+	if item == nil {
+		return nil
+	}
+
+	for i := range m.state.EC2Instances {
+		if m.state.EC2Instances[i].InstanceID == item.ID {
+			inst := m.state.EC2Instances[i]
+			m.ec2ActionTarget = &inst
+			m.ec2ActionCursor = 0
+			m.ec2ActionActive = true
+			return nil
+		}
+	}
+	return nil
+}
+
+// handleEC2ActionKey handles the lifecycle action picker.
+func (m *Model) handleEC2ActionKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		m.ec2ActionActive = false
+		m.ec2ActionTarget = nil
+		return nil
+
+	case "up", "k":
+		if m.ec2ActionCursor > 0 {
+			m.ec2ActionCursor--
+		}
+		return nil
+
+	case "down", "j":
+		if m.ec2ActionCursor < len(ec2Actions)-1 {
+			m.ec2ActionCursor++
+		}
+		return nil
+
+	case "enter":
+		inst := m.ec2ActionTarget
+		action := ec2Actions[m.ec2ActionCursor]
+		m.ec2ActionActive = false
+		m.ec2ActionTarget = nil
+		if inst == nil {
+			return nil
+		}
+
+		// Protected instances may not be stopped or rebooted
+		if action != "start" && inst.Tags["vaws:protected"] == "true" {
+			m.logger.Error("%s is tagged vaws:protected=true - refusing to %s it", inst.InstanceID, action)
+			m.state.ShowLogs = true
+			m.updateComponentSizes()
+			return nil
+		}
+
+		instanceID := inst.InstanceID
+		m.logger.Info("Requested %s of instance %s...", action, instanceID)
+		return func() tea.Msg {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			var err error
+			switch action {
+			case "reboot":
+				err = m.client.RebootInstance(ctx, instanceID)
+			case "stop":
+				err = m.client.StopInstance(ctx, instanceID)
+			case "start":
+				err = m.client.StartInstance(ctx, instanceID)
+			}
+			return ec2ActionMsg{instanceID: instanceID, action: action, err: err}
+		}
+	}
+	return nil
+}
+
+// renderEC2ActionDialog renders the lifecycle action picker.
+func (m *Model) renderEC2ActionDialog() string {
+	inst := m.ec2ActionTarget
+	if inst == nil {
+		return ""
+	}
+
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.Warning).
+		Padding(1, 2).
+		Width(48)
+
+	titleStyle := lipgloss.NewStyle().Foreground(theme.Warning).Bold(true)
+	selectedStyle := lipgloss.NewStyle().Foreground(theme.Primary).Bold(true)
+	normalStyle := lipgloss.NewStyle().Foreground(theme.Text)
+	hintStyle := lipgloss.NewStyle().Foreground(theme.TextDim).Italic(true)
+
+	content := titleStyle.Render(fmt.Sprintf("%s (%s)", inst.Name, inst.InstanceID)) + "\n\n"
+	for i, action := range ec2Actions {
+		if i == m.ec2ActionCursor {
+			content += selectedStyle.Render("▸ "+action) + "\n"
+		} else {
+			content += normalStyle.Render("  "+action) + "\n"
+		}
+	}
+	content += "\n" + hintStyle.Render("Enter confirm • Esc cancel")
+
+	return dialogStyle.Render(content)
+}
+
+// ec2PollTick schedules the next instance state poll.
+func (m *Model) ec2PollTick() tea.Cmd {
+	return tea.Tick(ec2PollInterval, func(t time.Time) tea.Msg {
+		return ec2PollTickMsg(t)
+	})
+}
+
+// pollEC2State fetches the transitioning instance's state.
+func (m *Model) pollEC2State() tea.Cmd {
+	instanceID := m.ec2PollInstance
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		instanceState, err := m.client.GetInstanceState(ctx, instanceID)
+		return ec2StateMsg{instanceID: instanceID, state: instanceState, err: err}
+	}
+}
+
+// applyEC2State updates the row override (and, on terminal states, the
+// underlying instance) so the transition is visible in the list.
+func (m *Model) applyEC2State(instanceID, instanceState string) {
+	if m.ec2States == nil {
+		m.ec2States = make(map[string]string)
+	}
+
+	terminal := instanceState == "running" || instanceState == "stopped" || instanceState == "terminated"
+	if terminal {
+		delete(m.ec2States, instanceID)
+		for i := range m.state.EC2Instances {
+			if m.state.EC2Instances[i].InstanceID == instanceID {
+				m.state.EC2Instances[i].State = instanceState
+				break
+			}
+		}
+	} else {
+		m.ec2States[instanceID] = instanceState
+	}
+	m.updateEC2List()
+}
+
+// beginEC2Watch starts polling a transitioning instance's state.
+func (m *Model) beginEC2Watch(instanceID string) tea.Cmd {
+	m.ec2PollInstance = instanceID
+	m.ec2PollCount = 0
+	return m.ec2PollTick()
+}
@@ -0,0 +1,153 @@
+package ui
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"vaws/internal/ui/components"
+)
+
+// Purposes the shared structured editor can be opened for.
+const (
+	editorPurposeSQSSend   = "sqs-send"
+	editorPurposeLambdaEnv = "lambda-env"
+	editorPurposeInsights  = "insights"
+	editorPurposePartiQL   = "partiql"
+	editorPurposeItemEdit  = "item-edit"
+	editorPurposePutItem   = "put-item"
+	editorPurposeTunnelReq = "tunnel-req"
+	editorPurposeTags      = "tag-edit"
+)
+
+// secretKeyMarkers flag env var keys whose values are masked by default.
+var secretKeyMarkers = []string{"SECRET", "TOKEN", "PASSWORD", "KEY"}
+
+// looksSecret reports whether an env var key suggests a sensitive value.
+func looksSecret(key string) bool {
+	upper := strings.ToUpper(key)
+	for _, marker := range secretKeyMarkers {
+		if strings.Contains(upper, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// startEnvEdit opens the key-value editor (as JSON) for the selected
+// function's environment variables.
+func (m *Model) startEnvEdit() tea.Cmd {
+	item := m.lambdaList.SelectedItem()
+	if item == nil {
+		return nil
+	}
+
+	for i := range m.state.Functions {
+		if m.state.Functions[i].Name != item.ID {
+			continue
+		}
+		fn := m.state.Functions[i]
+		m.envEditFn = fn.Name
+
+		env := fn.Environment
+		if env == nil {
+			env = map[string]string{}
+		}
+		initial, err := json.MarshalIndent(env, "", "  ")
+		if err != nil {
+			m.logger.Error("Failed to render environment: %v", err)
+			return nil
+		}
+
+		m.editorPurpose = editorPurposeLambdaEnv
+		m.structuredEditor.SetFormat(components.FormatJSON, false)
+		m.structuredEditor.SetSize(m.width, m.height)
+		return m.structuredEditor.Activate("Environment: "+fn.Name, string(initial))
+	}
+	return nil
+}
+
+// handleEnvEditor routes keys to the env editor and applies the update on
+// submit. Removing a key from the JSON removes the variable.
+func (m *Model) handleEnvEditor(msg tea.Msg) tea.Cmd {
+	result, cmd := m.structuredEditor.Update(msg)
+	if result == nil {
+		return cmd
+	}
+	if result.Cancelled {
+		m.envEditFn = ""
+		return nil
+	}
+
+	var env map[string]string
+	if err := json.Unmarshal([]byte(result.Value), &env); err != nil {
+		m.logger.Error("Environment must be a flat JSON object of string values: %v", err)
+		m.envEditFn = ""
+		return nil
+	}
+
+	name := m.envEditFn
+	m.envEditFn = ""
+	m.logger.Info("Updating environment for %s (%d variables)...", name, len(env))
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		err := m.client.UpdateFunctionEnvironment(ctx, name, env)
+		return envUpdatedMsg{name: name, env: env, err: err}
+	}
+}
+
+// handleEnvUpdated applies a completed environment update.
+func (m *Model) handleEnvUpdated(msg envUpdatedMsg) {
+	if msg.err != nil {
+		m.logger.Error("Failed to update environment for %s: %v", msg.name, msg.err)
+		m.state.ShowLogs = true
+		m.updateComponentSizes()
+		return
+	}
+
+	for i := range m.state.Functions {
+		if m.state.Functions[i].Name == msg.name {
+			m.state.Functions[i].Environment = msg.env
+			break
+		}
+	}
+	m.logger.Info("Environment updated for %s", msg.name)
+	m.updateLambdaDetails()
+}
+
+// envDetailRows renders a function's environment variables, masking values
+// whose keys look sensitive unless the user revealed them.
+func (m *Model) envDetailRows(env map[string]string) []components.DetailRow {
+	if len(env) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(env))
+	for k := range env {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	rows := []components.DetailRow{
+		{Label: "", Value: ""}, // Spacer
+		{Label: "Env Vars", Value: ""},
+	}
+	for _, k := range keys {
+		value := env[k]
+		if !m.revealEnvVars && looksSecret(k) {
+			value = "••••••••"
+		}
+		rows = append(rows, components.DetailRow{
+			Label: "  " + truncateString(k, 14),
+			Value: value,
+		})
+	}
+	return rows
+}
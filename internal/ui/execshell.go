@@ -0,0 +1,150 @@
+package ui
+
+import (
+	"context"
+	"os/exec"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"vaws/internal/model"
+	"vaws/internal/state"
+)
+
+// startExecShell opens an interactive shell in the selected service's best
+// container via ECS Exec, paralleling the SSM tunnel plumbing.
+func (m *Model) startExecShell() tea.Cmd {
+	item := m.serviceList.SelectedItem()
+	if item == nil {
+		m.logger.Warn("Shell: no service selected")
+		return nil
+	}
+
+	var selected *model.Service
+	for i := range m.state.Services {
+		if m.state.Services[i].Name == item.ID {
+			selected = &m.state.Services[i]
+			break
+		}
+	}
+	if selected == nil || selected.ClusterARN == "" {
+		return nil
+	}
+
+	// The most common failure is execute-command being off on the service;
+	// catch it before shelling out and say how to fix it
+	if !selected.EnableExecuteCommand {
+		m.logger.Error("ECS Exec is disabled for %s - set enableExecuteCommand=true on the service and redeploy", selected.Name)
+		m.state.ShowLogs = true
+		m.updateComponentSizes()
+		return nil
+	}
+
+	service := *selected
+	m.logger.Info("Loading tasks for shell into %s...", service.Name)
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		tasks, err := m.client.ListTasksForService(ctx, service.ClusterARN, service.Name)
+		return execTasksLoadedMsg{service: service, tasks: tasks, err: err}
+	}
+}
+
+// handleExecTasksLoaded picks a container and hands the terminal to
+// `aws ecs execute-command`.
+func (m *Model) handleExecTasksLoaded(msg execTasksLoadedMsg) tea.Cmd {
+	if msg.err != nil {
+		m.logger.Error("Failed to load tasks for shell: %v", msg.err)
+		m.state.ShowLogs = true
+		m.updateComponentSizes()
+		return nil
+	}
+	if len(msg.tasks) == 0 {
+		m.logger.Error("No running tasks for %s - nothing to shell into", msg.service.Name)
+		m.state.ShowLogs = true
+		m.updateComponentSizes()
+		return nil
+	}
+
+	task := msg.tasks[0]
+	container := findBestContainer(task.Containers)
+	if container == nil {
+		m.logger.Error("No container with a RuntimeID in task %s - is the SSM agent healthy?", task.TaskID)
+		m.state.ShowLogs = true
+		m.updateComponentSizes()
+		return nil
+	}
+
+	args := []string{
+		"ecs", "execute-command",
+		"--cluster", msg.service.ClusterARN,
+		"--task", task.TaskID,
+		"--container", container.Name,
+		"--interactive",
+		"--command", "/bin/sh",
+	}
+	if m.state.Region != "" {
+		args = append(args, "--region", m.state.Region)
+	}
+	if m.state.Profile != "" {
+		args = append(args, "--profile", m.state.Profile)
+	}
+
+	m.logger.Info("Opening shell in %s/%s (task %s)...", msg.service.Name, container.Name, task.TaskID)
+	c := exec.Command("aws", args...)
+	return tea.ExecProcess(c, func(err error) tea.Msg {
+		return execShellFinishedMsg{service: msg.service.Name, err: err}
+	})
+}
+
+// handleExecShellFinished reports how the interactive shell ended.
+func (m *Model) handleExecShellFinished(msg execShellFinishedMsg) {
+	if msg.err != nil {
+		m.logger.Error("Shell into %s ended with error: %v", msg.service, msg.err)
+		m.logger.Error("If this was a TargetNotConnected/execute-command failure, check that enableExecuteCommand is on and the task was started after enabling it")
+		m.state.ShowLogs = true
+		m.updateComponentSizes()
+		return
+	}
+	m.logger.Info("Shell into %s closed", msg.service)
+}
+
+// startJumpHostShell opens an interactive SSM session to the selected EC2
+// instance. Bubbletea suspends and restores the terminal around the session
+// so the TUI redraws cleanly when it exits.
+func (m *Model) startJumpHostShell() tea.Cmd {
+	item := m.ec2List.SelectedItem()
+	if item == nil {
+		m.logger.Warn("Shell: no instance selected")
+		return nil
+	}
+	instanceID := item.ID
+
+	for i := range m.state.EC2Instances {
+		if m.state.EC2Instances[i].InstanceID == instanceID && !m.state.EC2Instances[i].SSMManaged {
+			m.logger.Error("%s is not SSM-managed - no session possible", instanceID)
+			return nil
+		}
+	}
+
+	args := []string{"ssm", "start-session", "--target", instanceID}
+	if m.state.Region != "" {
+		args = append(args, "--region", m.state.Region)
+	}
+	if m.state.Profile != "" {
+		args = append(args, "--profile", m.state.Profile)
+	}
+
+	m.logger.Info("Opening SSM session to %s...", instanceID)
+	c := exec.Command("aws", args...)
+	return tea.ExecProcess(c, func(err error) tea.Msg {
+		return execShellFinishedMsg{service: instanceID, err: err}
+	})
+}
+
+// execShellAvailable reports whether the shell action applies to the view.
+func (m *Model) execShellAvailable() bool {
+	return m.state.View == state.ViewServices
+}
@@ -0,0 +1,91 @@
+package ui
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"vaws/internal/model"
+	"vaws/internal/ui/components"
+)
+
+// loadFunctionURL fetches the selected function's URL configuration. A
+// second press once it's loaded copies the URL to the clipboard.
+func (m *Model) loadFunctionURL() tea.Cmd {
+	item := m.lambdaList.SelectedItem()
+	if item == nil {
+		return nil
+	}
+	name := item.ID
+
+	if cfg, ok := m.fnURLs[name]; ok {
+		if cfg == nil {
+			m.logger.Info("%s has no function URL", name)
+			return nil
+		}
+		if err := copyToClipboard(cfg.URL); err != nil {
+			m.logger.Warn("Clipboard not available - URL: %s", cfg.URL)
+			return nil
+		}
+		m.logger.Info("Copied function URL to clipboard: %s", cfg.URL)
+		return nil
+	}
+
+	m.logger.Info("Loading function URL config for %s...", name)
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		cfg, err := m.client.GetFunctionUrlConfig(ctx, name)
+		return fnURLLoadedMsg{name: name, config: cfg, err: err}
+	}
+}
+
+// handleFnURLLoaded caches the URL config and refreshes the details.
+func (m *Model) handleFnURLLoaded(msg fnURLLoadedMsg) {
+	if msg.err != nil {
+		m.logger.Error("Failed to load function URL for %s: %v", msg.name, msg.err)
+		return
+	}
+
+	if m.fnURLs == nil {
+		m.fnURLs = make(map[string]*model.FunctionUrl)
+	}
+	m.fnURLs[msg.name] = msg.config
+
+	if msg.config == nil {
+		m.logger.Info("%s has no function URL", msg.name)
+	} else {
+		m.logger.Info("Function URL loaded for %s (press U again to copy)", msg.name)
+	}
+	m.updateLambdaDetails()
+}
+
+// fnURLDetailRows renders the cached URL config for the detail panel.
+// Nothing renders until the config was fetched once (U).
+func (m *Model) fnURLDetailRows(name string) []components.DetailRow {
+	cfg, ok := m.fnURLs[name]
+	if !ok {
+		return nil
+	}
+
+	rows := []components.DetailRow{
+		{Label: "", Value: ""}, // Spacer
+	}
+	if cfg == nil {
+		rows = append(rows, components.DetailRow{Label: "Function URL", Value: "no function URL"})
+		return rows
+	}
+
+	rows = append(rows, components.DetailRow{Label: "Function URL", Value: cfg.URL})
+	rows = append(rows, components.DetailRow{Label: "  Auth", Value: cfg.AuthType})
+	if len(cfg.AllowOrigins) > 0 {
+		rows = append(rows, components.DetailRow{Label: "  CORS Origins", Value: strings.Join(cfg.AllowOrigins, ", ")})
+	}
+	if len(cfg.AllowMethods) > 0 {
+		rows = append(rows, components.DetailRow{Label: "  CORS Methods", Value: strings.Join(cfg.AllowMethods, ", ")})
+	}
+	return rows
+}
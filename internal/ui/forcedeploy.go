@@ -0,0 +1,101 @@
+package ui
+
+import (
+	"context"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"vaws/internal/ui/theme"
+)
+
+// startForceDeployConfirm opens the force-deployment confirmation for the
+// selected service.
+func (m *Model) startForceDeployConfirm() tea.Cmd {
+	item := m.serviceList.SelectedItem()
+	if item == nil {
+		m.logger.Warn("Deploy: no service selected")
+		return nil
+	}
+
+	for i := range m.state.Services {
+		if m.state.Services[i].Name == item.ID {
+			svc := m.state.Services[i]
+			if svc.ClusterARN == "" {
+				return nil
+			}
+			m.forceDeployService = &svc
+			m.forceDeployConfirmActive = true
+			return nil
+		}
+	}
+	return nil
+}
+
+// handleForceDeployConfirmKey handles the force-deployment confirmation.
+func (m *Model) handleForceDeployConfirmKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "enter", "y":
+		svc := m.forceDeployService
+		m.forceDeployConfirmActive = false
+		m.forceDeployService = nil
+		if svc == nil {
+			return nil
+		}
+
+		service := *svc
+		m.logger.Info("Forcing new deployment for %s...", service.Name)
+		return func() tea.Msg {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			err := m.client.ForceNewDeployment(ctx, service.ClusterARN, service.Name)
+			return forceDeployMsg{service: service, err: err}
+		}
+
+	case "esc", "n":
+		m.forceDeployConfirmActive = false
+		m.forceDeployService = nil
+		return nil
+	}
+	return nil
+}
+
+// renderForceDeployConfirmDialog renders the force-deployment confirmation.
+func (m *Model) renderForceDeployConfirmDialog() string {
+	svc := m.forceDeployService
+	if svc == nil {
+		return ""
+	}
+
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.Warning).
+		Padding(1, 2).
+		Width(52)
+
+	titleStyle := lipgloss.NewStyle().Foreground(theme.Warning).Bold(true)
+	normalStyle := lipgloss.NewStyle().Foreground(theme.Text)
+	hintStyle := lipgloss.NewStyle().Foreground(theme.TextDim).Italic(true)
+
+	return dialogStyle.Render(
+		titleStyle.Render("Force new deployment") + "\n\n" +
+			normalStyle.Render("Redeploy "+svc.Name+" with its current task definition?") + "\n" +
+			normalStyle.Render("Running tasks are replaced per the deployment config.") + "\n\n" +
+			hintStyle.Render("Enter/y deploy • Esc cancel"))
+}
+
+// handleForceDeploy applies the result of a forced deployment.
+func (m *Model) handleForceDeploy(msg forceDeployMsg) tea.Cmd {
+	if msg.err != nil {
+		m.logger.Error("Failed to force deployment for %s: %v", msg.service.Name, msg.err)
+		m.state.ShowLogs = true
+		m.updateComponentSizes()
+		return nil
+	}
+
+	// Watch the rollout until it stabilizes
+	m.logger.Info("Deployment started for %s", msg.service.Name)
+	return m.startScaleWatchService(msg.service)
+}
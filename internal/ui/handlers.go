@@ -2,7 +2,10 @@ package ui
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/charmbracelet/bubbles/textinput"
@@ -11,6 +14,7 @@ import (
 	"vaws/internal/aws"
 	"vaws/internal/model"
 	"vaws/internal/state"
+	"vaws/internal/ui/components"
 )
 
 // handleKeyMsg handles key messages when not in special input modes.
@@ -25,6 +29,143 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) tea.Cmd {
 		return m.handleDetailsSearchKey(msg)
 	}
 
+	// Handle global search input separately
+	if m.globalSearching {
+		return m.handleGlobalSearchKey(msg)
+	}
+
+	// Handle query template placeholder prompts separately
+	if m.enteringTemplateParam {
+		return m.handleTemplateParamKey(msg)
+	}
+
+	// Handle the context switcher overlay separately
+	if m.ctxSwitchActive {
+		return m.handleContextSwitchKey(msg)
+	}
+
+	// Handle SSO re-auth modal
+	if m.ssoLoginActive {
+		return m.handleSSOLoginKey(msg)
+	}
+
+	// Handle the help modal
+	if m.helpActive {
+		return m.handleHelpKey(msg)
+	}
+
+	// Handle the redrive dialog separately
+	if m.redriveActive {
+		return m.handleRedriveKey(msg)
+	}
+
+	// Handle the scaling-progress overlay separately
+	if m.scaleWatchActive {
+		return m.handleScaleWatchKey(msg)
+	}
+
+	// Handle the force-deployment confirmation separately
+	if m.forceDeployConfirmActive {
+		return m.handleForceDeployConfirmKey(msg)
+	}
+
+	// Handle the output destination chooser separately
+	if m.outputRouteActive {
+		return m.handleOutputRouteKey(msg)
+	}
+
+	// Handle the large-scan confirmation separately
+	if m.scanConfirmActive {
+		return m.handleScanConfirmKey(msg)
+	}
+
+	// Handle the shared structured editor separately, routed by purpose
+	if m.structuredEditor.IsActive() {
+		switch m.editorPurpose {
+		case editorPurposeLambdaEnv:
+			return m.handleEnvEditor(msg)
+		case editorPurposeInsights:
+			return m.handleInsightsEditor(msg)
+		case editorPurposePartiQL:
+			return m.handlePartiQLEditor(msg)
+		case editorPurposeItemEdit:
+			return m.handleItemEditor(msg)
+		case editorPurposePutItem:
+			return m.handlePutItemEditor(msg)
+		case editorPurposeTunnelReq:
+			return m.handleTunnelReqEditor(msg)
+		case editorPurposeTags:
+			return m.handleTagsEditor(msg)
+		default:
+			return m.handleSendMessageEditor(msg)
+		}
+	}
+
+	// Handle the FIFO send prompts separately
+	if m.sendMsgStage != sendMsgStageNone {
+		return m.handleSendMsgPromptKey(msg)
+	}
+
+	// Handle the purge confirmation separately
+	if m.purgeConfirmActive {
+		return m.handlePurgeConfirmKey(msg)
+	}
+
+	// Handle the message delete confirmation separately
+	if m.deleteMsgConfirmActive {
+		return m.handleDeleteMsgConfirmKey(msg)
+	}
+
+	// Handle the item delete confirmation separately
+	if m.itemDeleteConfirmActive {
+		return m.handleItemDeleteConfirmKey(msg)
+	}
+
+	// Handle the query index picker separately
+	if m.indexPickActive {
+		return m.handleIndexPickKey(msg)
+	}
+
+	// Handle the put-item overwrite-guard prompt separately
+	if m.putItemGuardActive {
+		return m.handlePutItemGuardKey(msg)
+	}
+
+	// Handle the tunnel request builder prompts separately
+	if m.tunnelReqStage != tunnelReqStageNone {
+		return m.handleTunnelReqKey(msg)
+	}
+
+	// Handle the stage variable prompt separately
+	if m.stageVarActive {
+		return m.handleStageVarKey(msg)
+	}
+
+	// Handle the EC2 lifecycle action picker separately
+	if m.ec2ActionActive {
+		return m.handleEC2ActionKey(msg)
+	}
+
+	// Handle the Lambda config edit form separately
+	if m.lambdaEditStage != lambdaEditStageNone {
+		return m.handleLambdaEditKey(msg)
+	}
+
+	// Handle the mapping toggle prompt separately
+	if m.esmToggleActive {
+		return m.handleESMToggleKey(msg)
+	}
+
+	// Handle the CloudWatch log filter input separately
+	if m.cwFiltering {
+		return m.handleCWFilterKey(msg)
+	}
+
+	// Handle the server-side filter pattern input separately
+	if m.cwPatternEditing {
+		return m.handleCWPatternKey(msg)
+	}
+
 	// Handle port input mode separately
 	if m.enteringPort {
 		return m.handlePortInputKey(msg)
@@ -108,6 +249,7 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) tea.Cmd {
 			m.details.ScrollUp()
 		} else {
 			m.moveCursorUp()
+			return m.afterCursorMove()
 		}
 
 	case matchKey(msg, m.keys.Down):
@@ -115,6 +257,7 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) tea.Cmd {
 			m.details.ScrollDown()
 		} else {
 			m.moveCursorDown()
+			return m.afterCursorMove()
 		}
 
 	case matchKey(msg, m.keys.Top):
@@ -194,6 +337,11 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) tea.Cmd {
 		return m.handlePortForward()
 
 	case matchKey(msg, m.keys.LambdaInvoke):
+		// 'i' fires a test request through the selected tunnel, and
+		// invokes functions in the Lambda view
+		if m.state.View == state.ViewTunnels {
+			return m.startTunnelRequest()
+		}
 		return m.handleLambdaInvoke()
 
 	case msg.String() == "s":
@@ -201,6 +349,271 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) tea.Cmd {
 		if m.state.View == state.ViewDynamoDB {
 			return m.handleDynamoDBScan()
 		}
+		// Interactive ECS Exec shell into the selected service
+		if m.execShellAvailable() {
+			return m.startExecShell()
+		}
+		// Plain SSM shell to the selected jump host
+		if m.state.View == state.ViewJumpHostSelect {
+			return m.startJumpHostShell()
+		}
+
+	case msg.String() == "o":
+		// Cycle Lambda list grouping: none -> stack -> prefix
+		if m.state.View == state.ViewLambda {
+			m.state.CycleLambdaGrouping()
+			m.logger.Info("Lambda grouping: %s", m.state.LambdaGroupingName())
+			m.updateLambdaList()
+			// The flat function list carries no tags - fetch them in the
+			// background so stack grouping has something to group on
+			if m.state.LambdaGrouping == state.LambdaGroupStack {
+				return m.loadFunctionTags()
+			}
+		}
+
+	case msg.String() == "v":
+		// Cycle Lambda VPC attachment filter: all -> vpc-only -> non-vpc
+		if m.state.View == state.ViewLambda {
+			m.state.CycleLambdaVPCFilter()
+			m.logger.Info("Lambda VPC filter: %s", m.state.LambdaVPCFilterName())
+			m.updateLambdaList()
+		}
+		// Freshest events for the selected service
+		if m.state.View == state.ViewServices {
+			return m.loadServiceEvents()
+		}
+		// Event history for the selected stack
+		if m.state.View == state.ViewStacks {
+			return m.loadStackEvents()
+		}
+
+	case msg.String() == " ":
+		// Space toggles group collapse in the grouped Lambda list
+		if m.state.View == state.ViewLambda {
+			return m.handleEnter()
+		}
+
+	case msg.String() == "S":
+		// Global resource search across loaded lists
+		return m.startGlobalSearch()
+
+	case msg.String() == "R":
+		// Recently viewed resources quick-jump list
+		m.showRecentResources()
+
+	case msg.String() == "T":
+		// Saved query templates for the selected DynamoDB table
+		if m.state.View == state.ViewDynamoDB {
+			return m.showQueryTemplates()
+		}
+		// Tag editor for the selected queue or function
+		if m.state.View == state.ViewSQS {
+			return m.startQueueTagEdit()
+		}
+		if m.state.View == state.ViewLambda {
+			return m.startFunctionTagEdit()
+		}
+
+	case msg.String() == "#":
+		// Exact item count for the selected table (paged COUNT scan)
+		if m.state.View == state.ViewDynamoDB {
+			return m.startExactCount()
+		}
+
+	case msg.String() == "Q":
+		// PartiQL statement editor for the selected table
+		if m.state.View == state.ViewDynamoDB {
+			return m.startPartiQLEditor()
+		}
+
+	case msg.String() == "+":
+		// New item form for the selected table
+		if m.state.View == state.ViewDynamoDB {
+			return m.startPutItem()
+		}
+
+	case msg.String() == "C":
+		// Copy all active tunnel endpoints to the clipboard
+		if m.state.View == state.ViewTunnels {
+			m.copyTunnelEndpoints()
+		}
+
+	case msg.String() == "B":
+		// Re-establish tunnels that died with the previous session
+		if m.state.View == state.ViewTunnels {
+			return m.restorePreviousTunnels()
+		}
+
+	case msg.String() == "Y" && (m.state.View == state.ViewTunnels || m.state.View == state.ViewStacks):
+		// Guarded by view so other views fall through to the yank binding
+		if m.state.View == state.ViewTunnels {
+			// Copy the selected tunnel's connection address
+			m.copySelectedTunnel()
+		} else {
+			// Copy the selected stack's outputs as key=value lines
+			m.copyStackOutputs()
+		}
+
+	case msg.String() == "m":
+		// Send a message to the selected queue
+		if m.state.View == state.ViewSQS {
+			return m.startSendMessage()
+		}
+
+	case msg.String() == "P":
+		// Purge the selected queue (with confirmation)
+		if m.state.View == state.ViewSQS {
+			return m.startPurgeConfirm()
+		}
+
+	case msg.String() == "b":
+		// Peek at message bodies without consuming them
+		if m.state.View == state.ViewSQS {
+			return m.startPeekMessages()
+		}
+
+	case msg.String() == "x" && m.state.View == state.ViewSQSMessages:
+		// Delete the selected peeked message ('x' stops tunnels elsewhere)
+		return m.startDeleteMessageConfirm()
+
+	case msg.String() == "D":
+		// Redrive DLQ messages for the selected queue
+		if m.state.View == state.ViewSQS {
+			return m.startRedrivePreview()
+		}
+		// Task definition for the selected service
+		if m.state.View == state.ViewServices {
+			return m.loadTaskDefinition()
+		}
+
+	case msg.String() == "M":
+		// Status of the last started message move task (SQS) or event
+		// source mappings (Lambda)
+		if m.state.View == state.ViewSQS {
+			return m.checkRedriveStatus()
+		}
+		if m.state.View == state.ViewLambda {
+			return m.loadEventSourceMappings()
+		}
+
+	case msg.String() == "J" && m.state.View == state.ViewLambda:
+		// Jump to the SQS queue triggering the selected function
+		// ('J' scrolls logs elsewhere)
+		return m.jumpToTriggeringQueue()
+
+	case msg.String() == "X":
+		// Cancel the in-progress message move task
+		if m.state.View == state.ViewSQS {
+			return m.cancelRedrive()
+		}
+
+	case msg.String() == "w":
+		// Watch the selected service scale until stable
+		if m.state.View == state.ViewServices {
+			return m.startScaleWatch()
+		}
+		// Download the selected function's deployment package
+		if m.state.View == state.ViewLambda {
+			return m.downloadFunctionCode()
+		}
+
+	case msg.String() == "U":
+		// Function URL config for the selected function (again to copy)
+		if m.state.View == state.ViewLambda {
+			return m.loadFunctionURL()
+		}
+
+	case msg.String() == "d":
+		// Force a new deployment of the selected service
+		if m.state.View == state.ViewServices {
+			return m.startForceDeployConfirm()
+		}
+
+	case msg.String() == "A":
+		// Show CloudWatch alarms for the selected queue/function
+		if m.state.View == state.ViewSQS || m.state.View == state.ViewLambda {
+			return m.loadResourceAlarms()
+		}
+
+	case msg.String() == "e":
+		// Route the last invocation result to a file/clipboard/editor
+		if m.state.View == state.ViewLambda {
+			return m.openOutputRoute()
+		}
+		// Export the selected stage's OpenAPI spec as JSON
+		if m.state.View == state.ViewAPIStages {
+			return m.exportAPISpec(false)
+		}
+
+	case msg.String() == "E" && m.state.View == state.ViewLambda:
+		// Edit memory/timeout for the selected function; elsewhere 'E'
+		// keeps its scroll-logs-to-end meaning
+		return m.startLambdaEdit()
+
+	case msg.String() == "E" && m.state.View == state.ViewAPIStages:
+		// Export the selected stage's OpenAPI spec as YAML
+		return m.exportAPISpec(true)
+
+	case msg.String() == "V":
+		// Edit the selected function's environment variables
+		if m.state.View == state.ViewLambda {
+			return m.startEnvEdit()
+		}
+		// Drift detection for the selected stack
+		if m.state.View == state.ViewStacks {
+			return m.detectStackDrift()
+		}
+		// Set a stage variable on the selected API stage
+		if m.state.View == state.ViewAPIStages {
+			return m.startStageVarEdit()
+		}
+
+	case msg.String() == "z":
+		// Aliases and versions for the selected function
+		if m.state.View == state.ViewLambda {
+			return m.startAliasesView()
+		}
+
+	case msg.String() == "O":
+		// Enable/disable an event source mapping
+		if m.state.View == state.ViewLambda {
+			return m.startESMToggle()
+		}
+		// Outputs and parameters for the selected stack
+		if m.state.View == state.ViewStacks {
+			return m.loadStackInfo()
+		}
+
+	case msg.String() == "I" && m.state.View == state.ViewJumpHostSelect:
+		// Instance lifecycle actions (reboot/stop/start)
+		return m.startEC2Action()
+
+	case msg.String() == "u":
+		// Toggle masking of secret-looking env var values
+		if m.state.View == state.ViewLambda {
+			m.revealEnvVars = !m.revealEnvVars
+			if m.revealEnvVars {
+				m.logger.Warn("Secret-looking env values revealed")
+			} else {
+				m.logger.Info("Secret-looking env values masked")
+			}
+			m.updateLambdaDetails()
+		}
+
+	case msg.String() == "F":
+		// Temporarily disable/enable config-defined default filter rules
+		m.state.DefaultFiltersOff = !m.state.DefaultFiltersOff
+		if m.state.DefaultFiltersOff {
+			m.logger.Info("Default filter rules disabled - showing everything")
+		} else {
+			m.logger.Info("Default filter rules enabled")
+		}
+		m.updateCurrentList()
+
+	case msg.String() == "x" && m.state.View != state.ViewTunnels && m.state.View != state.ViewSQSMessages:
+		// Combined profile/region switcher ('x' stops tunnels in the tunnels
+		// view and deletes messages in the peek view)
+		return m.openContextSwitch()
 
 	case matchKey(msg, m.keys.Tunnels):
 		m.showTunnelsView()
@@ -273,11 +686,7 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) tea.Cmd {
 			m.cloudWatchLogsPanel.Clear()
 			return m.fetchCloudWatchLogs()
 		}
-		// Toggle focus between list and details in split view
-		if m.getLayoutMode() == layoutFull && m.state.View != state.ViewTunnels &&
-			m.state.View != state.ViewDynamoDBQuery {
-			m.details.SetFocused(!m.details.IsFocused())
-		}
+		m.cycleFocus()
 
 	case msg.String() == "shift+tab":
 		// Switch to previous container in CloudWatch logs view
@@ -288,6 +697,7 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) tea.Cmd {
 			m.cloudWatchLogsPanel.Clear()
 			return m.fetchCloudWatchLogs()
 		}
+		m.cycleFocus()
 
 	case matchKey(msg, m.keys.CopyMode):
 		// Enter copy mode in full layout (split view)
@@ -346,6 +756,23 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) tea.Cmd {
 	return nil
 }
 
+// cycleFocus moves keyboard focus between the visible panes. In the two-pane
+// master-detail layout this toggles between list and details (Tab and
+// Shift+Tab are equivalent with two panes); full-screen views and single-pane
+// layouts have nothing to cycle. Navigation keys route to whichever pane has
+// focus: the list moves its cursor, the details pane scrolls, and the border
+// color (theme.BorderFocus) marks the focused side.
+func (m *Model) cycleFocus() {
+	if m.getLayoutMode() != layoutFull {
+		return
+	}
+	switch m.state.View {
+	case state.ViewTunnels, state.ViewDynamoDBQuery, state.ViewCloudWatchLogs:
+		return // full-screen views have a single pane
+	}
+	m.details.SetFocused(!m.details.IsFocused())
+}
+
 // handleFilterKey handles key messages when in filter mode.
 func (m *Model) handleFilterKey(msg tea.KeyMsg) tea.Cmd {
 	switch {
@@ -430,18 +857,29 @@ func (m *Model) handleProfileSelectKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 }
 
 // handleRegionSelectKey handles key messages in region selection view.
+// Printable keys feed the type-to-filter, so navigation is arrows-only here.
 func (m *Model) handleRegionSelectKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
 	case "esc":
-		// Cancel region selection, go back to previous view
+		// Clear an active filter first; cancel the view otherwise
+		if m.regionSelector.HasFilter() {
+			m.regionSelector.ClearFilter()
+			return m, nil
+		}
 		m.state.View = m.viewBeforeRegionSelect
 		return m, nil
 
-	case "up", "k":
+	case "backspace":
+		m.regionSelector.BackspaceFilter()
+		return m, nil
+
+	case "up":
 		m.regionSelector.Up()
+		return m, m.probeRegionLatency(m.regionSelector.SelectedRegion())
 
-	case "down", "j":
+	case "down":
 		m.regionSelector.Down()
+		return m, m.probeRegionLatency(m.regionSelector.SelectedRegion())
 
 	case "enter":
 		// Select the region and create new AWS client
@@ -453,6 +891,7 @@ func (m *Model) handleRegionSelectKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 
 		m.logger.Info("Changing region to: %s", selectedRegion)
+		m.rememberCustomRegion(selectedRegion)
 
 		// Create new AWS client with new region
 		return m, func() tea.Msg {
@@ -464,9 +903,59 @@ func (m *Model) handleRegionSelectKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 	}
 
+	// Any printable key feeds the type-to-filter
+	if msg.Type == tea.KeyRunes {
+		m.regionSelector.TypeFilter(string(msg.Runes))
+		return m, m.probeRegionLatency(m.regionSelector.SelectedRegion())
+	}
+
 	return m, nil
 }
 
+// rememberCustomRegion persists a region code that isn't in the built-in
+// list, so later sessions offer it without retyping.
+func (m *Model) rememberCustomRegion(code string) {
+	for _, group := range components.AWSRegions {
+		for _, region := range group.Regions {
+			if region.Code == code {
+				return
+			}
+		}
+	}
+	if m.cfg == nil {
+		return
+	}
+	for _, existing := range m.cfg.CustomRegions {
+		if existing == code {
+			return
+		}
+	}
+
+	m.cfg.CustomRegions = append(m.cfg.CustomRegions, code)
+	if err := m.cfg.Save(); err != nil {
+		m.logger.Warn("Failed to save custom region to config: %v", err)
+	}
+	m.regionSelector.SetCustomRegions(m.cfg.CustomRegions)
+}
+
+// probeRegionLatency measures round-trip latency to a region unless a
+// measurement is already cached for the session.
+func (m *Model) probeRegionLatency(region string) tea.Cmd {
+	if region == "" {
+		return nil
+	}
+	if _, ok := m.regionLatencies[region]; ok {
+		return nil
+	}
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		latency, err := aws.ProbeRegionLatency(ctx, region)
+		return regionLatencyMsg{region: region, latency: latency, err: err}
+	}
+}
+
 // handleEnter handles the enter key press based on current view.
 func (m *Model) handleEnter() tea.Cmd {
 	switch m.state.View {
@@ -501,6 +990,7 @@ func (m *Model) handleEnter() tea.Cmd {
 		for i := range m.state.Clusters {
 			if m.state.Clusters[i].Name == item.ID {
 				m.state.SelectCluster(&m.state.Clusters[i])
+				m.recordRecent("cluster", m.state.Clusters[i].Name, "")
 				m.state.FilterText = ""
 				m.filterInput.SetValue("")
 				return m.loadServicesForCluster()
@@ -516,6 +1006,7 @@ func (m *Model) handleEnter() tea.Cmd {
 		for i := range m.state.Stacks {
 			if m.state.Stacks[i].Name == item.ID {
 				m.state.SelectStack(&m.state.Stacks[i])
+				m.recordRecent("stack", m.state.Stacks[i].Name, string(m.state.Stacks[i].Status))
 				m.state.FilterText = ""
 				m.filterInput.SetValue("")
 				m.updateStackResourcesList()
@@ -543,8 +1034,42 @@ func (m *Model) handleEnter() tea.Cmd {
 		case "sqs-queues":
 			m.state.View = state.ViewSQS
 			return m.loadQueues()
+		case "all-resources":
+			return m.openStackTree()
+		}
+		return nil
+	case state.ViewStackTree:
+		// Jump to the selected resource's native view
+		return m.openStackTreeResource()
+	case state.ViewServices:
+		// Drill into the task-level overview for the selected service
+		return m.openTaskList()
+	case state.ViewTasks:
+		// Drill into the task's containers, reusing the picker flow
+		return m.drillIntoTaskContainers()
+	case state.ViewLambda:
+		item := m.lambdaList.SelectedItem()
+		if item == nil {
+			return nil
+		}
+		// Toggle collapse when a group header is selected in grouped mode
+		if strings.HasPrefix(item.ID, lambdaGroupIDPrefix) {
+			m.state.ToggleLambdaGroup(strings.TrimPrefix(item.ID, lambdaGroupIDPrefix))
+			m.updateLambdaList()
 		}
 		return nil
+	case state.ViewGlobalSearch:
+		// Drill into the selected search result's native view
+		idx := m.globalSearchList.Cursor()
+		if idx >= 0 && idx < len(m.globalSearchResults) {
+			return m.openGlobalSearchResult(m.globalSearchResults[idx])
+		}
+		return nil
+	case state.ViewQueryTemplates:
+		return m.selectQueryTemplate()
+	case state.ViewLambdaAliases:
+		// Invoke the selected alias/version with a Qualifier
+		return m.invokeSelectedQualifier()
 	case state.ViewAPIGateway:
 		item := m.apiGatewayList.SelectedItem()
 		if item == nil {
@@ -556,6 +1081,7 @@ func (m *Model) handleEnter() tea.Cmd {
 			for i := range m.state.RestAPIs {
 				if m.state.RestAPIs[i].ID == apiID {
 					m.state.SelectRestAPI(&m.state.RestAPIs[i])
+					m.recordRecent("api", m.state.RestAPIs[i].Name, "REST")
 					m.state.FilterText = ""
 					m.filterInput.SetValue("")
 					return m.loadAPIStages()
@@ -566,12 +1092,22 @@ func (m *Model) handleEnter() tea.Cmd {
 			for i := range m.state.HttpAPIs {
 				if m.state.HttpAPIs[i].ID == apiID {
 					m.state.SelectHttpAPI(&m.state.HttpAPIs[i])
+					m.recordRecent("api", m.state.HttpAPIs[i].Name, m.state.HttpAPIs[i].ProtocolType)
 					m.state.FilterText = ""
 					m.filterInput.SetValue("")
 					return m.loadAPIStages()
 				}
 			}
 		}
+	case state.ViewAPIStages:
+		// v2 APIs (HTTP and WebSocket) drill into their route keys
+		if m.state.SelectedHttpAPI != nil {
+			m.state.View = state.ViewAPIRoutes
+			m.state.FilterText = ""
+			m.filterInput.SetValue("")
+			return m.loadAPIRoutes()
+		}
+		return nil
 	case state.ViewJumpHostSelect:
 		// User selected a jump host for private API Gateway tunnel
 		item := m.ec2List.SelectedItem()
@@ -638,6 +1174,29 @@ func (m *Model) handleEnter() tea.Cmd {
 
 // handleBack handles the back/escape key press based on current view.
 func (m *Model) handleBack() {
+	// Esc during an in-flight lazy load cancels it instead of navigating,
+	// so a wrong load in a huge account stops burning API quota
+	switch m.state.View {
+	case state.ViewLambda:
+		if m.state.FunctionsLoading {
+			m.cancelFunctionsLoad()
+			m.updateLambdaList()
+			return
+		}
+	case state.ViewSQS:
+		if m.state.QueuesLoading {
+			m.cancelQueuesLoad()
+			m.updateQueuesList()
+			return
+		}
+	case state.ViewDynamoDB:
+		if m.state.TablesLoading {
+			m.cancelTablesLoad()
+			m.updateTablesList()
+			return
+		}
+	}
+
 	switch m.state.View {
 	case state.ViewStacks:
 		// Go back to main menu
@@ -646,12 +1205,23 @@ func (m *Model) handleBack() {
 		m.filterInput.SetValue("")
 		m.state.ClearStacks()
 		m.updateMainMenuList()
+	case state.ViewStackTree:
+		m.state.View = state.ViewStackResources
+		m.state.FilterText = ""
+		m.filterInput.SetValue("")
+		m.updateStackResourcesList()
 	case state.ViewStackResources:
 		m.state.View = state.ViewStacks
 		m.state.SelectedStack = nil
 		m.state.FilterText = ""
 		m.filterInput.SetValue("")
 		m.updateStacksList()
+	case state.ViewTasks:
+		m.state.FilterText = ""
+		m.filterInput.SetValue("")
+		m.state.ClearTasks()
+		m.state.View = state.ViewServices
+		m.updateServicesList()
 	case state.ViewServices:
 		m.state.FilterText = ""
 		m.filterInput.SetValue("")
@@ -708,6 +1278,11 @@ func (m *Model) handleBack() {
 		m.state.FilterText = ""
 		m.filterInput.SetValue("")
 		m.updateAPIGatewayList()
+	case state.ViewAPIRoutes:
+		m.state.GoBack()
+		m.state.FilterText = ""
+		m.filterInput.SetValue("")
+		m.updateAPIStagesList()
 	case state.ViewJumpHostSelect:
 		// Go back to API stages, clear pending tunnel info
 		m.state.View = state.ViewAPIStages
@@ -744,16 +1319,41 @@ func (m *Model) handleBack() {
 		} else {
 			m.state.View = state.ViewStacks
 		}
+	case state.ViewGlobalSearch:
+		// Return to wherever the search was launched from
+		m.state.View = m.viewBeforeGlobalSearch
+		m.updateCurrentList()
+	case state.ViewQueryTemplates:
+		m.state.View = state.ViewDynamoDB
+		m.updateTablesList()
+	case state.ViewSQSMessages:
+		m.state.PeekedMessages = nil
+		m.state.View = state.ViewSQS
+		m.updateQueuesList()
+	case state.ViewLambdaAliases:
+		m.fnAliases = nil
+		m.fnVersions = nil
+		m.state.View = state.ViewLambda
+		m.updateLambdaList()
 	}
 }
 
 // handleRefresh handles the refresh key press based on current view.
 func (m *Model) handleRefresh() tea.Cmd {
+	// A manual refresh always hits the API
+	if m.client != nil {
+		m.client.InvalidateDescribeCache()
+	}
+
 	switch m.state.View {
 	case state.ViewStacks:
 		return m.loadStacks()
+	case state.ViewClusters:
+		return m.loadClusters()
 	case state.ViewServices:
 		return m.loadServices()
+	case state.ViewTasks:
+		return m.refreshTaskList()
 	case state.ViewLambda:
 		return m.loadFunctions()
 	case state.ViewAPIGateway:
@@ -809,6 +1409,7 @@ func (m *Model) handleCloudWatchLogs() tea.Cmd {
 		return nil
 	}
 
+	m.recordRecent("service", selectedService.Name, selectedService.ClusterName)
 	m.logger.Info("Loading CloudWatch logs for service: %s", selectedService.Name)
 
 	// First, fetch tasks to get task ID
@@ -859,6 +1460,7 @@ func (m *Model) handleLambdaCloudWatchLogs() tea.Cmd {
 		return nil
 	}
 
+	m.recordRecent("function", selectedFn.Name, selectedFn.Runtime)
 	m.logger.Info("Loading CloudWatch logs for Lambda: %s", selectedFn.Name)
 
 	// Set up Lambda context and transition to CloudWatch logs view
@@ -872,6 +1474,8 @@ func (m *Model) handleLambdaCloudWatchLogs() tea.Cmd {
 		LogStreamName: "", // Lambda logs query across all streams
 	}
 
+	m.cloudWatchFetchLimit = 0
+	m.cloudWatchFetchTimedOut = false
 	m.state.ClearCloudWatchLogs()
 	m.state.CloudWatchLogConfigs = []model.ContainerLogConfig{config}
 	m.state.CloudWatchLambdaContext = &fn
@@ -1066,17 +1670,37 @@ func (m *Model) handleLambdaInvoke() tea.Cmd {
 		return nil
 	}
 
+	m.recordRecent("function", selectedFn.Name, selectedFn.Runtime)
+
 	// Set up payload input dialog
 	m.enteringPayload = true
 	m.pendingInvokeFunction = selectedFn
 	m.payloadInput.Reset()
 	m.payloadInput.Focus()
+	m.preflightWarning = ""
 
 	m.logger.Info("Opening payload dialog for Lambda: %s", selectedFn.Name)
 
+	// Optionally preflight the invoke permission while the dialog is open
+	if m.cfg != nil && m.cfg.Defaults.PermissionPreflight {
+		return tea.Batch(textinput.Blink, m.preflightAction("lambda:InvokeFunction", selectedFn.ARN))
+	}
+
 	return textinput.Blink
 }
 
+// preflightAction simulates an IAM action asynchronously so confirmation
+// dialogs can warn about likely denials without blocking input.
+func (m *Model) preflightAction(action, resource string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		allowed, ok := m.client.SimulateAction(ctx, action, resource)
+		return preflightResultMsg{action: action, resource: resource, allowed: allowed, ok: ok}
+	}
+}
+
 // handlePayloadInputKey handles key messages when entering a Lambda payload.
 func (m *Model) handlePayloadInputKey(msg tea.KeyMsg) tea.Cmd {
 	switch msg.String() {
@@ -1087,24 +1711,72 @@ func (m *Model) handlePayloadInputKey(msg tea.KeyMsg) tea.Cmd {
 		m.enteringPayload = false
 		m.payloadInput.Blur()
 		m.pendingInvokeFunction = nil
+		m.preflightWarning = ""
 
 		if fn == nil {
 			return nil
 		}
 
+		// Support file payloads (file://path), mirroring `aws lambda invoke`.
+		// The response and decoded logs are then written next to the file.
+		var responsePath string
+		if strings.HasPrefix(payload, "file://") {
+			path := strings.TrimPrefix(payload, "file://")
+			data, err := os.ReadFile(path)
+			if err != nil {
+				m.logger.Error("Failed to read payload file: %v", err)
+				return nil
+			}
+			if !json.Valid(data) {
+				m.logger.Error("Payload file %s is not valid JSON", path)
+				return nil
+			}
+			payload = string(data)
+			responsePath = path + ".response.json"
+		}
+
+		// Event mode returns immediately on acceptance; there is no
+		// response payload to render or write
+		if m.invokeAsync {
+			if m.invokeQualifier != "" {
+				m.logger.Warn("Async invokes target $LATEST - alias/version %q ignored", m.invokeQualifier)
+				m.invokeQualifier = ""
+			}
+			m.logger.Info("Invoking Lambda %s asynchronously with payload: %s", fn.Name, truncateString(payload, 50))
+			functionName := fn.Name
+			return func() tea.Msg {
+				ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+				defer cancel()
+
+				err := m.client.InvokeFunctionAsync(ctx, functionName, payload)
+				return asyncInvokedMsg{name: functionName, err: err}
+			}
+		}
+
 		// Clear previous invocation state
 		m.state.ClearLambdaInvocation()
 		m.state.LambdaInvocationLoading = true
 		m.updateLambdaDetails()
 
-		m.logger.Info("Invoking Lambda %s with payload: %s", fn.Name, truncateString(payload, 50))
+		qualifier := m.invokeQualifier
+		m.invokeQualifier = ""
+		if qualifier != "" {
+			m.logger.Info("Invoking Lambda %s:%s with payload: %s", fn.Name, qualifier, truncateString(payload, 50))
+		} else {
+			m.logger.Info("Invoking Lambda %s with payload: %s", fn.Name, truncateString(payload, 50))
+		}
 
 		functionName := fn.Name
 		return func() tea.Msg {
 			ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
 			defer cancel()
 
-			result, err := m.client.InvokeFunction(ctx, functionName, payload)
+			result, err := m.client.InvokeFunctionQualified(ctx, functionName, qualifier, payload)
+			if err == nil && responsePath != "" {
+				if werr := writeInvocationOutputs(result, responsePath); werr != nil {
+					m.logger.Warn("Failed to write invocation response: %v", werr)
+				}
+			}
 			return lambdaInvocationResultMsg{result: result, err: err}
 		}
 
@@ -1112,6 +1784,13 @@ func (m *Model) handlePayloadInputKey(msg tea.KeyMsg) tea.Cmd {
 		m.enteringPayload = false
 		m.payloadInput.Blur()
 		m.pendingInvokeFunction = nil
+		m.invokeQualifier = ""
+		m.preflightWarning = ""
+		return nil
+
+	case "tab":
+		// Switch between Request/Response and Event (async) modes
+		m.invokeAsync = !m.invokeAsync
 		return nil
 	}
 
@@ -1202,6 +1881,12 @@ func (m *Model) handleRestartTunnel() tea.Cmd {
 
 // handleAPIGatewayPortForward starts port forwarding for the selected API Gateway stage.
 func (m *Model) handleAPIGatewayPortForward() tea.Cmd {
+	// The local proxy speaks plain HTTP; WebSocket upgrade isn't supported
+	if m.state.SelectedHttpAPI != nil && m.state.SelectedHttpAPI.ProtocolType == "WEBSOCKET" {
+		m.logger.Warn("WebSocket tunneling not supported - connect to the wss endpoint directly")
+		return nil
+	}
+
 	item := m.apiStagesList.SelectedItem()
 	if item == nil {
 		m.logger.Warn("Port forward: no API stage selected")
@@ -1243,6 +1928,30 @@ func (m *Model) handleAPIGatewayPortForward() tea.Cmd {
 	return textinput.Blink
 }
 
+// startExactCount kicks off a paged COUNT scan for the selected table.
+// The DescribeTable figure is only updated every ~6 hours; this trades a
+// full-table read (with its capacity cost) for the precise number.
+func (m *Model) startExactCount() tea.Cmd {
+	table := m.dynamodbTable.SelectedTable()
+	if table == nil {
+		m.logger.Warn("Exact count: no table selected")
+		return nil
+	}
+
+	estimatedRCU := table.SizeBytes / 4096 / 2
+	m.logger.Info("Counting items in %s - reads the whole table (~%d RCUs), this may take a while...", table.Name, estimatedRCU)
+
+	name := table.Name
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancel()
+
+		start := time.Now()
+		count, err := m.client.ExactItemCount(ctx, name)
+		return exactCountMsg{table: name, count: count, elapsed: time.Since(start), err: err}
+	}
+}
+
 // handleDynamoDBQuery opens the query dialog for the selected table.
 func (m *Model) handleDynamoDBQuery() tea.Cmd {
 	if m.state.View != state.ViewDynamoDB {
@@ -1256,8 +1965,16 @@ func (m *Model) handleDynamoDBQuery() tea.Cmd {
 	}
 
 	m.state.SelectTable(table)
+	m.recordRecent("table", table.Name, string(table.Status))
 	m.logger.Info("Opening query dialog for table: %s", table.Name)
 
+	// Tables with secondary indexes get an index picker first so GSI
+	// access patterns are queryable
+	m.pendingIndexName = ""
+	if len(table.GlobalSecondaryIndexes)+len(table.LocalSecondaryIndexes) > 0 {
+		return m.startIndexPick(table)
+	}
+
 	// Set size for dialog
 	m.dynamodbQueryDialog.SetSize(m.width, m.height)
 
@@ -1277,6 +1994,7 @@ func (m *Model) handleDynamoDBScan() tea.Cmd {
 	}
 
 	m.state.SelectTable(table)
+	m.recordRecent("table", table.Name, string(table.Status))
 	m.logger.Info("Opening scan dialog for table: %s", table.Name)
 
 	// Set size for dialog
@@ -1296,6 +2014,11 @@ func (m *Model) handleDynamoDBQueryDialogKey(msg tea.KeyMsg) tea.Cmd {
 
 		// Execute the query or scan
 		if result.QueryParams != nil {
+			// Carry the index chosen in the picker, if any
+			if m.pendingIndexName != "" {
+				result.QueryParams.IndexName = m.pendingIndexName
+				m.pendingIndexName = ""
+			}
 			m.state.DynamoDBQueryParams = result.QueryParams
 			m.state.DynamoDBScanParams = nil
 			m.state.DynamoDBIsQuery = true
@@ -1307,16 +2030,8 @@ func (m *Model) handleDynamoDBQueryDialogKey(msg tea.KeyMsg) tea.Cmd {
 			m.logger.Info("Executing query on table: %s (PK: %s)", result.QueryParams.TableName, result.QueryParams.PartitionKeyVal)
 			return m.executeDynamoDBQuery(result.QueryParams)
 		} else if result.ScanParams != nil {
-			m.state.DynamoDBQueryParams = nil
-			m.state.DynamoDBScanParams = result.ScanParams
-			m.state.DynamoDBIsQuery = false
-			m.state.DynamoDBQueryLoading = true
-			m.state.DynamoDBLastKey = nil
-			m.state.View = state.ViewDynamoDBQuery
-			m.dynamodbQueryResults.SetLoading(true)
-			m.dynamodbQueryResults.Clear()
-			m.logger.Info("Executing scan on table: %s", result.ScanParams.TableName)
-			return m.executeDynamoDBScan(result.ScanParams)
+			// Large unfiltered scans get a cost-estimate confirmation first
+			return m.confirmOrStartScan(result.ScanParams)
 		}
 	}
 	return cmd
@@ -1410,7 +2125,11 @@ func (m *Model) handleDynamoDBQueryResultsKey(msg tea.KeyMsg) tea.Cmd {
 		return nil
 
 	case "r":
-		// Re-run the query/scan
+		// Re-run the PartiQL statement or query/scan
+		if m.partiqlStatement != "" {
+			m.dynamodbQueryResults.Clear()
+			return m.executePartiQL(m.partiqlStatement, nil)
+		}
 		if m.state.DynamoDBIsQuery && m.state.DynamoDBQueryParams != nil {
 			m.state.DynamoDBQueryLoading = true
 			m.state.DynamoDBLastKey = nil
@@ -1434,6 +2153,29 @@ func (m *Model) handleDynamoDBQueryResultsKey(msg tea.KeyMsg) tea.Cmd {
 		// Disable mouse capture to allow terminal text selection
 		return tea.DisableMouse
 
+	case "e":
+		// Edit the selected item (changed attributes only)
+		return m.startItemEdit()
+
+	case "x":
+		// Delete the selected item (with confirmation)
+		return m.startItemDeleteConfirm()
+
+	case "c":
+		// Copy the selected item as an aws dynamodb put-item command
+		item := m.dynamodbQueryResults.SelectedItem()
+		if item == nil || item.AttributeJSON == "" || m.state.SelectedTable == nil {
+			m.logger.Warn("No item selected to copy as put-item")
+			return nil
+		}
+		cmd := m.client.PutItemCommand(m.state.SelectedTable.Name, item.AttributeJSON)
+		if err := copyToClipboard(cmd); err != nil {
+			m.logger.Warn("Clipboard not available: %v", err)
+			return nil
+		}
+		m.logger.Info("put-item command copied to clipboard")
+		return nil
+
 	case "Y":
 		// Yank current item's JSON to clipboard
 		text := m.dynamodbQueryResults.SelectedJSON()
@@ -1493,12 +2235,34 @@ func (m *Model) handleCloudWatchLogsKey(msg tea.KeyMsg) (tea.Cmd, bool) {
 		return tea.Quit, true
 
 	case "esc", "backspace":
-		// Go back to tasks view
+		// Stop any in-flight Insights query, then go back to tasks view
+		m.cancelInsightsQuery()
+		m.cwFilterInput.SetValue("")
+		m.cloudWatchLogsPanel.SetFilter("")
+		m.cwServerPattern = ""
 		m.state.CloudWatchLogsStreaming = false
 		m.cloudWatchLogsPanel.SetStreaming(false)
 		m.state.View = state.ViewTasks
 		return nil, true
 
+	case "I":
+		// Run a Logs Insights query over this log group
+		return m.startInsightsQuery(), true
+
+	case "/":
+		// Filter loaded entries client-side, live as you type
+		m.cwFiltering = true
+		m.cwFilterInput.SetValue("")
+		m.cwFilterInput.Focus()
+		return textinput.Blink, true
+
+	case "F":
+		// Server-side filter pattern applied by FilterLogEvents
+		m.cwPatternEditing = true
+		m.cwPatternInput.SetValue(m.cwServerPattern)
+		m.cwPatternInput.Focus()
+		return textinput.Blink, true
+
 	case "up", "k":
 		m.cloudWatchLogsPanel.ScrollUp()
 		return nil, true
@@ -1542,12 +2306,185 @@ func (m *Model) handleCloudWatchLogsKey(msg tea.KeyMsg) (tea.Cmd, bool) {
 		m.state.CloudWatchLogs = nil
 		m.state.CloudWatchLastFetchTime = 0
 		return m.fetchCloudWatchLogs(), true
+
+	case "R":
+		// Retry a timed-out fetch with a reduced limit
+		if !m.cloudWatchFetchTimedOut {
+			return nil, true
+		}
+		m.cloudWatchFetchLimit = reducedLogFetchLimit(m.currentLogFetchLimit())
+		m.cloudWatchFetchTimedOut = false
+		m.logger.Info("Retrying CloudWatch fetch with limit %d", m.cloudWatchFetchLimit)
+		if m.state.CloudWatchLambdaContext != nil {
+			logGroup := fmt.Sprintf("/aws/lambda/%s", m.state.CloudWatchLambdaContext.Name)
+			return m.fetchLambdaCloudWatchLogs(logGroup), true
+		}
+		return m.fetchCloudWatchLogs(), true
 	}
 
 	// Not handled - let main handler process (for shortcuts like 1,2,3,4)
 	return nil, false
 }
 
+// reducedLogFetchLimit halves the per-fetch limit for timeout retries,
+// bottoming out at 10 entries.
+func reducedLogFetchLimit(limit int32) int32 {
+	limit /= 2
+	if limit < 10 {
+		limit = 10
+	}
+	return limit
+}
+
+// handleCWFilterKey handles the client-side log filter input. The filter
+// applies live on every keystroke without re-fetching from AWS.
+func (m *Model) handleCWFilterKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "enter":
+		// Keep the filter applied and leave input mode
+		m.cwFiltering = false
+		m.cwFilterInput.Blur()
+		return nil
+
+	case "esc":
+		// Clear the filter, restoring the full view
+		m.cwFiltering = false
+		m.cwFilterInput.SetValue("")
+		m.cloudWatchLogsPanel.SetFilter("")
+		m.cwFilterInput.Blur()
+		return nil
+	}
+
+	var cmd tea.Cmd
+	m.cwFilterInput, cmd = m.cwFilterInput.Update(msg)
+	m.cloudWatchLogsPanel.SetFilter(m.cwFilterInput.Value())
+	return cmd
+}
+
+// handleCWPatternKey handles the server-side filter pattern input. Applying
+// a pattern restarts the tail so AWS filters the whole stream; an empty
+// pattern clears the filter.
+func (m *Model) handleCWPatternKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "enter":
+		m.cwPatternEditing = false
+		m.cwPatternInput.Blur()
+		m.cwServerPattern = strings.TrimSpace(m.cwPatternInput.Value())
+		if m.cwServerPattern == "" {
+			m.logger.Info("Server-side filter cleared")
+		} else {
+			m.logger.Info("Server-side filter: %s", m.cwServerPattern)
+		}
+
+		// Restart the incremental tail under the new pattern
+		m.cloudWatchLogsPanel.Clear()
+		m.state.CloudWatchLogs = nil
+		m.state.CloudWatchLastFetchTime = 0
+		if m.state.CloudWatchLambdaContext != nil {
+			logGroup := fmt.Sprintf("/aws/lambda/%s", m.state.CloudWatchLambdaContext.Name)
+			return m.fetchLambdaCloudWatchLogs(logGroup)
+		}
+		return m.fetchCloudWatchLogs()
+
+	case "esc":
+		m.cwPatternEditing = false
+		m.cwPatternInput.Blur()
+		return nil
+	}
+
+	var cmd tea.Cmd
+	m.cwPatternInput, cmd = m.cwPatternInput.Update(msg)
+	return cmd
+}
+
+// activeList returns the list component behind the current view, or nil for
+// table- and panel-based views.
+func (m *Model) activeList() *components.List {
+	switch m.state.View {
+	case state.ViewMain:
+		return m.mainMenuList
+	case state.ViewStacks:
+		return m.stacksList
+	case state.ViewStackResources:
+		return m.stackResourcesList
+	case state.ViewStackTree:
+		return m.stackTreeList
+	case state.ViewClusters:
+		return m.clustersList
+	case state.ViewServices:
+		return m.serviceList
+	case state.ViewTasks:
+		return m.tasksList
+	case state.ViewLambda:
+		return m.lambdaList
+	case state.ViewAPIGateway:
+		return m.apiGatewayList
+	case state.ViewAPIStages:
+		return m.apiStagesList
+	case state.ViewAPIRoutes:
+		return m.apiRoutesList
+	case state.ViewJumpHostSelect:
+		return m.ec2List
+	case state.ViewContainerSelect:
+		return m.containerList
+	case state.ViewGlobalSearch:
+		return m.globalSearchList
+	case state.ViewQueryTemplates:
+		return m.templatesList
+	case state.ViewSQSMessages:
+		return m.peekList
+	case state.ViewLambdaAliases:
+		return m.aliasList
+	}
+	return nil
+}
+
+// doubleClickWindow is how close two clicks on the same row must be to act
+// as a selection.
+const doubleClickWindow = 400 * time.Millisecond
+
+// handleMouseClick moves the list cursor to a clicked row; a second click on
+// the same row within the double-click window acts like Enter. Opt-in via
+// defaults.mouse_clicks because mouse reporting is noisy over some SSH
+// setups. Keyboard navigation is untouched.
+func (m *Model) handleMouseClick(x, y int) tea.Cmd {
+	if m.cfg == nil || !m.cfg.Defaults.MouseClicks {
+		return nil
+	}
+	if m.showSplash || m.idleLocked || m.dialogOpen() {
+		return nil
+	}
+
+	// Only clicks in the list pane move the cursor
+	if m.getLayoutMode() == layoutFull {
+		listWidth := int(float64(m.width) * listPaneRatio)
+		if x >= listWidth {
+			return nil
+		}
+	}
+
+	list := m.activeList()
+	if list == nil {
+		return nil
+	}
+
+	// Content starts below the status bar (1), container title (1) and the
+	// top border (1)
+	if !list.ClickRow(y - 3) {
+		return nil
+	}
+	m.updateCurrentList()
+
+	now := time.Now()
+	if y == m.lastClickY && now.Sub(m.lastClickAt) < doubleClickWindow {
+		m.lastClickAt = time.Time{}
+		return m.handleEnter()
+	}
+	m.lastClickAt = now
+	m.lastClickY = y
+	return nil
+}
+
 // handleMouseWheelUp handles mouse wheel scroll up events.
 func (m *Model) handleMouseWheelUp(x int) {
 	// CloudWatch logs view - scroll logs
@@ -0,0 +1,112 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"vaws/internal/ui/theme"
+)
+
+// openHelp opens the full help modal built from the registered bindings.
+func (m *Model) openHelp() {
+	m.helpActive = true
+	m.helpScroll = 0
+	m.updateQuickBarActions() // refresh the per-view action registry
+}
+
+// handleHelpKey handles keys while the help modal is open.
+func (m *Model) handleHelpKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc", "?", "q":
+		m.helpActive = false
+	case "up", "k":
+		if m.helpScroll > 0 {
+			m.helpScroll--
+		}
+	case "down", "j":
+		m.helpScroll++
+	}
+	return nil
+}
+
+// helpLines builds the full help content: the current view's actions from
+// the quick-bar registry, then the global binding groups - so the overlay
+// stays in sync as features and custom keymaps change.
+func (m *Model) helpLines() []string {
+	keyStyle := lipgloss.NewStyle().Foreground(theme.Primary).Bold(true)
+	groupStyle := lipgloss.NewStyle().Foreground(theme.Info).Bold(true)
+	descStyle := lipgloss.NewStyle().Foreground(theme.Text)
+
+	render := func(k, desc string) string {
+		return fmt.Sprintf("  %s %s", keyStyle.Render(fmt.Sprintf("%-12s", k)), descStyle.Render(desc))
+	}
+
+	var lines []string
+
+	if actions := m.quickBar.ActionKeys(); len(actions) > 0 {
+		lines = append(lines, groupStyle.Render("This view"))
+		for _, a := range actions {
+			lines = append(lines, render(a.Key, a.Label))
+		}
+		lines = append(lines, "")
+	}
+
+	for _, group := range m.keys.ReferenceGroups() {
+		lines = append(lines, groupStyle.Render(group.Name))
+		for _, binding := range group.Bindings {
+			h := binding.Help()
+			lines = append(lines, render(h.Key, h.Desc))
+		}
+		lines = append(lines, "")
+	}
+	return lines
+}
+
+// renderHelpDialog renders the scrollable help modal.
+func (m *Model) renderHelpDialog() string {
+	dialogWidth := 52
+	if m.width < 62 {
+		dialogWidth = m.width - 10
+		if dialogWidth < 30 {
+			dialogWidth = 30
+		}
+	}
+
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.BorderFocus).
+		Padding(1, 2).
+		Width(dialogWidth)
+
+	titleStyle := lipgloss.NewStyle().Foreground(theme.Primary).Bold(true)
+	hintStyle := lipgloss.NewStyle().Foreground(theme.TextDim).Italic(true)
+
+	lines := m.helpLines()
+
+	// Window the lines to the terminal height, scrolled by helpScroll
+	maxLines := m.height - 10
+	if maxLines < 5 {
+		maxLines = 5
+	}
+	if m.helpScroll > len(lines)-maxLines {
+		m.helpScroll = len(lines) - maxLines
+	}
+	if m.helpScroll < 0 {
+		m.helpScroll = 0
+	}
+	end := min(m.helpScroll+maxLines, len(lines))
+	visible := lines[m.helpScroll:end]
+
+	content := titleStyle.Render("Help") + "\n\n" +
+		strings.Join(visible, "\n") + "\n\n"
+	if len(lines) > maxLines {
+		content += hintStyle.Render(fmt.Sprintf("↑↓ scroll (%d/%d) • Esc/? close", end, len(lines)))
+	} else {
+		content += hintStyle.Render("Esc/? close")
+	}
+
+	return dialogStyle.Render(content)
+}
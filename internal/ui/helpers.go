@@ -1,14 +1,25 @@
 package ui
 
 import (
+	"encoding/base64"
 	"fmt"
+	"os"
+	"strings"
 
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 
+	"vaws/internal/config"
 	"vaws/internal/model"
 )
 
+// Size unit modes understood by formatBytes (config: defaults.size_units).
+const (
+	sizeUnitsBinary = "binary" // 1024 divisor with legacy KB/MB labels (default)
+	sizeUnitsIEC    = "iec"    // 1024 divisor with KiB/MiB labels
+	sizeUnitsSI     = "si"     // 1000 divisor with kB/MB labels
+)
+
 // formatDuration formats seconds into a human-readable duration string.
 func formatDuration(seconds int) string {
 	if seconds >= 86400 {
@@ -24,18 +35,34 @@ func formatDuration(seconds int) string {
 	return fmt.Sprintf("%ds", seconds)
 }
 
-// formatBytes formats bytes into a human-readable string.
+// formatBytes formats bytes into a human-readable string using the
+// configured size units (binary with legacy labels, IEC, or decimal SI).
 func formatBytes(bytes int64) string {
-	const unit = 1024
+	mode := config.Get().Defaults.SizeUnits
+
+	var unit int64 = 1024
+	if mode == sizeUnitsSI {
+		unit = 1000
+	}
+
 	if bytes < unit {
 		return fmt.Sprintf("%d B", bytes)
 	}
-	div, exp := int64(unit), 0
+	div, exp := unit, 0
 	for n := bytes / unit; n >= unit; n /= unit {
 		div *= unit
 		exp++
 	}
-	return fmt.Sprintf("%.1f %cB", float64(bytes)/float64(div), "KMGTPE"[exp])
+	value := float64(bytes) / float64(div)
+
+	switch mode {
+	case sizeUnitsIEC:
+		return fmt.Sprintf("%.1f %ciB", value, "KMGTPE"[exp])
+	case sizeUnitsSI:
+		return fmt.Sprintf("%.1f %cB", value, "kMGTPE"[exp])
+	default: // sizeUnitsBinary and unset
+		return fmt.Sprintf("%.1f %cB", value, "KMGTPE"[exp])
+	}
 }
 
 // truncateString truncates a string to fit within maxWidth.
@@ -52,6 +79,86 @@ func truncateString(s string, maxWidth int) string {
 	return s[:maxWidth-3] + "..."
 }
 
+// installDefaultFilters copies config-defined default include/exclude rules
+// into state so the Filtered* helpers apply them after each load.
+func (m *Model) installDefaultFilters() {
+	if m.cfg == nil {
+		return
+	}
+	for key, rules := range m.cfg.Filters {
+		m.state.SetDefaultFilter(key, rules.Include, rules.Exclude)
+	}
+}
+
+// autoSelectSingle reports whether single-result loads should auto-select
+// their only item and drill into the next view.
+func (m *Model) autoSelectSingle() bool {
+	return m.cfg != nil && m.cfg.Defaults.AutoSelectSingle
+}
+
+// writeInvocationOutputs writes the invocation response payload and decoded
+// execution log to files next to the payload file, mirroring the output-file
+// behavior of `aws lambda invoke`. Written paths are recorded on the result.
+func writeInvocationOutputs(result *model.InvocationResult, responsePath string) error {
+	if err := os.WriteFile(responsePath, []byte(result.Payload), 0644); err != nil {
+		return err
+	}
+	result.ResponsePath = responsePath
+
+	if result.LogResult != "" {
+		decoded, err := base64.StdEncoding.DecodeString(result.LogResult)
+		if err == nil {
+			logsPath := strings.TrimSuffix(responsePath, ".response.json") + ".logs.txt"
+			if err := os.WriteFile(logsPath, decoded, 0644); err == nil {
+				result.LogsPath = logsPath
+			}
+		}
+	}
+
+	return nil
+}
+
+// sparklineLevels are the block characters used for sparkline rendering.
+var sparklineLevels = []rune("▁▂▃▄▅▆▇█")
+
+// sparkline renders a metric series as a fixed-width unicode sparkline,
+// bucketing the series to width cells (max per bucket) and scaling against
+// the series maximum.
+func sparkline(points []model.MetricPoint, width int) string {
+	if len(points) == 0 || width <= 0 {
+		return ""
+	}
+	if width > len(points) {
+		width = len(points)
+	}
+
+	// Bucket by max so short spikes stay visible
+	buckets := make([]float64, width)
+	maxVal := 0.0
+	for i := range buckets {
+		start := len(points) * i / width
+		end := len(points) * (i + 1) / width
+		for _, p := range points[start:end] {
+			if p.Value > buckets[i] {
+				buckets[i] = p.Value
+			}
+		}
+		if buckets[i] > maxVal {
+			maxVal = buckets[i]
+		}
+	}
+
+	var b strings.Builder
+	for _, v := range buckets {
+		level := 0
+		if maxVal > 0 {
+			level = int(v / maxVal * float64(len(sparklineLevels)-1))
+		}
+		b.WriteRune(sparklineLevels[level])
+	}
+	return b.String()
+}
+
 // matchKey checks if a key message matches a key binding.
 func matchKey(msg tea.KeyMsg, binding key.Binding) bool {
 	for _, k := range binding.Keys() {
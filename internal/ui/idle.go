@@ -0,0 +1,98 @@
+package ui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// idleCheckInterval is how often idle state is evaluated while the
+// idle-timeout feature is enabled.
+const idleCheckInterval = 30 * time.Second
+
+// idleTickMsg drives the idle-timeout checks.
+type idleTickMsg time.Time
+
+// clockTickMsg drives the status bar clock.
+type clockTickMsg time.Time
+
+// clockTick schedules the next 1s clock update.
+func (m *Model) clockTick() tea.Cmd {
+	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
+		return clockTickMsg(t)
+	})
+}
+
+// idleTimeout returns the configured idle timeout, 0 when disabled.
+func (m *Model) idleTimeout() time.Duration {
+	if m.cfg == nil || m.cfg.Defaults.IdleTimeoutMinutes <= 0 {
+		return 0
+	}
+	return time.Duration(m.cfg.Defaults.IdleTimeoutMinutes) * time.Minute
+}
+
+// idleTick schedules the next idle check when the feature is enabled.
+func (m *Model) idleTick() tea.Cmd {
+	if m.idleTimeout() == 0 {
+		return nil
+	}
+	return tea.Tick(idleCheckInterval, func(t time.Time) tea.Msg {
+		return idleTickMsg(t)
+	})
+}
+
+// handleIdleTick warns shortly before the timeout and applies the configured
+// action (lock, close-tunnels, or exit) once it is reached.
+func (m *Model) handleIdleTick() (tea.Model, tea.Cmd) {
+	timeout := m.idleTimeout()
+	if timeout == 0 {
+		return m, nil
+	}
+
+	action := m.cfg.Defaults.IdleAction
+	if action == "" {
+		action = "lock"
+	}
+
+	idle := time.Since(m.lastInputAt)
+	if idle >= timeout {
+		switch action {
+		case "exit":
+			m.logger.Warn("Idle timeout reached - exiting")
+			if m.tunnelManager != nil {
+				m.tunnelManager.StopAllTunnels()
+			}
+			return m, tea.Quit
+
+		case "close-tunnels":
+			m.logger.Warn("Idle timeout reached - closing all tunnels")
+			if m.tunnelManager != nil {
+				m.tunnelManager.StopAllTunnels()
+			}
+			if m.apiGWManager != nil {
+				m.apiGWManager.StopAllTunnels()
+			}
+			m.updateTunnelsPanel()
+			m.lastInputAt = time.Now() // don't re-fire every tick
+
+		default: // lock
+			if !m.idleLocked {
+				m.logger.Warn("Idle timeout reached - UI locked")
+				m.idleLocked = true
+			}
+		}
+		return m, m.idleTick()
+	}
+
+	// Countdown warning ahead of the action
+	if !m.idleWarned && idle >= timeout-time.Minute {
+		remaining := (timeout - idle).Round(time.Second)
+		m.logger.Warn("Idle for %s - will %s in %s (any key resets the timer)",
+			idle.Round(time.Second), action, remaining)
+		m.idleWarned = true
+		m.state.ShowLogs = true
+		m.updateComponentSizes()
+	}
+
+	return m, m.idleTick()
+}
@@ -0,0 +1,133 @@
+package ui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"vaws/internal/model"
+	"vaws/internal/ui/theme"
+)
+
+// indexOption is one choice in the query index picker.
+type indexOption struct {
+	name   string // empty for the base table
+	label  string
+	pk     string
+	sk     string
+	status string
+}
+
+// buildIndexOptions lists the base table and its indexes as query targets.
+func buildIndexOptions(table *model.Table) []indexOption {
+	options := []indexOption{{
+		label:  "base table",
+		pk:     table.PartitionKey(),
+		sk:     table.SortKey(),
+		status: "ACTIVE",
+	}}
+
+	for i := range table.GlobalSecondaryIndexes {
+		gsi := &table.GlobalSecondaryIndexes[i]
+		pk, sk := gsi.Keys()
+		options = append(options, indexOption{
+			name:   gsi.IndexName,
+			label:  fmt.Sprintf("GSI %s (%s)", gsi.IndexName, gsi.Status),
+			pk:     pk,
+			sk:     sk,
+			status: gsi.Status,
+		})
+	}
+	for i := range table.LocalSecondaryIndexes {
+		lsi := &table.LocalSecondaryIndexes[i]
+		pk, sk := lsi.Keys()
+		options = append(options, indexOption{
+			name:   lsi.IndexName,
+			label:  "LSI " + lsi.IndexName,
+			pk:     pk,
+			sk:     sk,
+			status: "ACTIVE", // LSIs have no lifecycle status
+		})
+	}
+	return options
+}
+
+// startIndexPick opens the index picker ahead of the query dialog.
+func (m *Model) startIndexPick(table *model.Table) tea.Cmd {
+	m.indexPickOptions = buildIndexOptions(table)
+	m.indexPickCursor = 0
+	m.indexPickActive = true
+	return nil
+}
+
+// handleIndexPickKey handles the index picker.
+func (m *Model) handleIndexPickKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		m.indexPickActive = false
+		return nil
+
+	case "up", "k":
+		if m.indexPickCursor > 0 {
+			m.indexPickCursor--
+		}
+		return nil
+
+	case "down", "j":
+		if m.indexPickCursor < len(m.indexPickOptions)-1 {
+			m.indexPickCursor++
+		}
+		return nil
+
+	case "enter":
+		option := m.indexPickOptions[m.indexPickCursor]
+		if option.status != "ACTIVE" {
+			m.logger.Error("Index %s is %s - wait until it's ACTIVE to query it", option.name, option.status)
+			return nil
+		}
+		m.indexPickActive = false
+		m.pendingIndexName = option.name
+
+		table := m.state.SelectedTable
+		if table == nil {
+			return nil
+		}
+		// The dialog prompts for the chosen index's key attributes
+		m.dynamodbQueryDialog.SetSize(m.width, m.height)
+		return m.dynamodbQueryDialog.Activate(table.Name, option.pk, option.sk, true)
+	}
+	return nil
+}
+
+// renderIndexPickDialog renders the index picker.
+func (m *Model) renderIndexPickDialog() string {
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.BorderFocus).
+		Padding(1, 2).
+		Width(54)
+
+	titleStyle := lipgloss.NewStyle().Foreground(theme.Primary).Bold(true)
+	selectedStyle := lipgloss.NewStyle().Foreground(theme.Primary).Bold(true)
+	normalStyle := lipgloss.NewStyle().Foreground(theme.Text)
+	dimStyle := lipgloss.NewStyle().Foreground(theme.TextDim)
+	hintStyle := lipgloss.NewStyle().Foreground(theme.TextDim).Italic(true)
+
+	content := titleStyle.Render("Query via") + "\n\n"
+	for i, option := range m.indexPickOptions {
+		keys := option.pk
+		if option.sk != "" {
+			keys += " / " + option.sk
+		}
+		line := fmt.Sprintf("%s  %s", option.label, dimStyle.Render("("+keys+")"))
+		if i == m.indexPickCursor {
+			content += selectedStyle.Render("▸ ") + normalStyle.Render(line) + "\n"
+		} else {
+			content += "  " + normalStyle.Render(line) + "\n"
+		}
+	}
+	content += "\n" + hintStyle.Render("↑↓ select • Enter query • Esc cancel")
+
+	return dialogStyle.Render(content)
+}
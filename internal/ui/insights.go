@@ -0,0 +1,113 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"vaws/internal/model"
+	"vaws/internal/ui/components"
+)
+
+// defaultInsightsQuery is the starting point offered in the query editor.
+const defaultInsightsQuery = "fields @timestamp, @message | sort @timestamp desc | limit 50"
+
+// insightsLookback is the time range an Insights query covers.
+const insightsLookback = time.Hour
+
+// startInsightsQuery opens the query editor for the current log group.
+func (m *Model) startInsightsQuery() tea.Cmd {
+	logGroup := ""
+	if m.state.CloudWatchLambdaContext != nil {
+		logGroup = fmt.Sprintf("/aws/lambda/%s", m.state.CloudWatchLambdaContext.Name)
+	} else if logConfig := m.cloudWatchLogsPanel.SelectedContainer(); logConfig != nil {
+		logGroup = logConfig.LogGroup
+	}
+	if logGroup == "" {
+		m.logger.Warn("Insights: no log group in context")
+		return nil
+	}
+
+	m.insightsLogGroup = logGroup
+	m.editorPurpose = editorPurposeInsights
+	m.structuredEditor.SetFormat(components.FormatText, false)
+	m.structuredEditor.SetSize(m.width, m.height)
+	return m.structuredEditor.Activate("Insights query: "+logGroup, defaultInsightsQuery)
+}
+
+// handleInsightsEditor routes keys to the query editor and runs the query
+// on submit.
+func (m *Model) handleInsightsEditor(msg tea.Msg) tea.Cmd {
+	result, cmd := m.structuredEditor.Update(msg)
+	if result == nil {
+		return cmd
+	}
+	if result.Cancelled || strings.TrimSpace(result.Value) == "" {
+		return nil
+	}
+
+	query := result.Value
+	logGroup := m.insightsLogGroup
+
+	// Pause live tailing while Insights results are on screen
+	m.state.CloudWatchLogsStreaming = false
+	m.cloudWatchLogsPanel.SetStreaming(false)
+
+	// Keep a cancel handle so Esc stops the query server-side
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	m.insightsCancel = cancel
+
+	m.logger.Info("Running Insights query over the last %s...", insightsLookback)
+	return func() tea.Msg {
+		defer cancel()
+
+		end := time.Now()
+		rows, err := m.client.RunLogsInsightsQuery(ctx, logGroup, query, end.Add(-insightsLookback), end)
+		return insightsResultMsg{rows: rows, err: err}
+	}
+}
+
+// handleInsightsResult renders completed query results into the logs panel.
+func (m *Model) handleInsightsResult(msg insightsResultMsg) {
+	m.insightsCancel = nil
+
+	if msg.err != nil {
+		m.logger.Error("Insights query failed: %v", msg.err)
+		m.state.ShowLogs = true
+		m.updateComponentSizes()
+		return
+	}
+
+	entries := make([]model.CloudWatchLogEntry, 0, len(msg.rows))
+	for _, row := range msg.rows {
+		entry := model.CloudWatchLogEntry{}
+		var parts []string
+		for _, field := range row {
+			if field.Field == "@timestamp" {
+				if t, err := time.Parse("2006-01-02 15:04:05.000", field.Value); err == nil {
+					entry.Timestamp = t
+					continue
+				}
+			}
+			parts = append(parts, field.Field+"="+field.Value)
+		}
+		entry.Message = strings.Join(parts, " | ")
+		entries = append(entries, entry)
+	}
+
+	m.cloudWatchLogsPanel.SetEntries(entries)
+	m.cloudWatchLogsPanel.ScrollToTop()
+	m.logger.Info("Insights returned %d row(s) - Esc leaves the logs view", len(entries))
+}
+
+// cancelInsightsQuery stops an in-flight query, if any.
+func (m *Model) cancelInsightsQuery() {
+	if m.insightsCancel != nil {
+		m.insightsCancel()
+		m.insightsCancel = nil
+		m.logger.Info("Insights query cancelled")
+	}
+}
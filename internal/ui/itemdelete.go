@@ -0,0 +1,132 @@
+package ui
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"vaws/internal/ui/theme"
+)
+
+// startItemDeleteConfirm opens the delete confirmation for the selected
+// result row, deriving the key from the table's key schema rather than
+// assuming attribute names so composite keys work.
+func (m *Model) startItemDeleteConfirm() tea.Cmd {
+	item := m.dynamodbQueryResults.SelectedItem()
+	if item == nil || item.AttributeJSON == "" || m.state.SelectedTable == nil {
+		m.logger.Warn("Delete: no item selected")
+		return nil
+	}
+
+	m.itemDeleteTarget = item.AttributeJSON
+	m.itemDeleteConfirmActive = true
+	return nil
+}
+
+// itemDeleteKeySummary renders the target item's key for the confirmation.
+func (m *Model) itemDeleteKeySummary() string {
+	table := m.state.SelectedTable
+	if table == nil {
+		return ""
+	}
+	var attrs map[string]interface{}
+	if err := json.Unmarshal([]byte(m.itemDeleteTarget), &attrs); err != nil {
+		return ""
+	}
+
+	summary := fmt.Sprintf("%s = %v", table.PartitionKey(), wireScalar(attrs[table.PartitionKey()]))
+	if sk := table.SortKey(); sk != "" {
+		summary += fmt.Sprintf(", %s = %v", sk, wireScalar(attrs[sk]))
+	}
+	return summary
+}
+
+// wireScalar extracts the scalar inside a wire-format value for display.
+func wireScalar(v interface{}) interface{} {
+	if wrapper, ok := v.(map[string]interface{}); ok {
+		for _, inner := range wrapper {
+			return inner
+		}
+	}
+	return v
+}
+
+// handleItemDeleteConfirmKey handles the delete confirmation.
+func (m *Model) handleItemDeleteConfirmKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "enter", "y":
+		target := m.itemDeleteTarget
+		m.itemDeleteConfirmActive = false
+		m.itemDeleteTarget = ""
+
+		table := m.state.SelectedTable
+		if table == nil || target == "" {
+			return nil
+		}
+
+		var attrs map[string]interface{}
+		if err := json.Unmarshal([]byte(target), &attrs); err != nil {
+			m.logger.Error("Failed to parse item: %v", err)
+			return nil
+		}
+		keyGeneric := map[string]interface{}{table.PartitionKey(): attrs[table.PartitionKey()]}
+		if sk := table.SortKey(); sk != "" {
+			keyGeneric[sk] = attrs[sk]
+		}
+		key, err := marshalGenericAttributes(keyGeneric)
+		if err != nil {
+			m.logger.Error("Invalid key value: %v", err)
+			return nil
+		}
+
+		tableName := table.Name
+		m.logger.Info("Deleting item from %s...", tableName)
+		return func() tea.Msg {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			err := m.client.DeleteItem(ctx, tableName, key)
+			return itemDeletedFromTableMsg{table: tableName, err: err}
+		}
+
+	case "esc", "n":
+		m.itemDeleteConfirmActive = false
+		m.itemDeleteTarget = ""
+		return nil
+	}
+	return nil
+}
+
+// renderItemDeleteConfirmDialog renders the delete confirmation with the key.
+func (m *Model) renderItemDeleteConfirmDialog() string {
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.Error).
+		Padding(1, 2).
+		Width(56)
+
+	titleStyle := lipgloss.NewStyle().Foreground(theme.Error).Bold(true)
+	normalStyle := lipgloss.NewStyle().Foreground(theme.Text)
+	hintStyle := lipgloss.NewStyle().Foreground(theme.TextDim).Italic(true)
+
+	return dialogStyle.Render(
+		titleStyle.Render("⚠ Delete item") + "\n\n" +
+			normalStyle.Render("Key: "+m.itemDeleteKeySummary()) + "\n" +
+			normalStyle.Render("This cannot be undone.") + "\n\n" +
+			hintStyle.Render("Enter/y delete • Esc cancel"))
+}
+
+// handleItemDeletedFromTable reports the delete outcome.
+func (m *Model) handleItemDeletedFromTable(msg itemDeletedFromTableMsg) {
+	if msg.err != nil {
+		m.logger.Error("Failed to delete item: %v", msg.err)
+		m.state.ShowLogs = true
+		m.updateComponentSizes()
+		return
+	}
+	m.logger.Info("Item deleted from %s - press r to refresh the results", msg.table)
+}
@@ -0,0 +1,141 @@
+package ui
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"time"
+
+	dbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"vaws/internal/aws"
+	"vaws/internal/ui/components"
+)
+
+// startItemEdit opens the selected result item in the structured editor as
+// AttributeValue wire JSON, so S/N/BOOL/M/L types survive the round trip.
+func (m *Model) startItemEdit() tea.Cmd {
+	item := m.dynamodbQueryResults.SelectedItem()
+	if item == nil || item.AttributeJSON == "" || m.state.SelectedTable == nil {
+		m.logger.Warn("Edit: no item selected")
+		return nil
+	}
+
+	// Pretty-print the wire JSON for editing
+	var generic map[string]interface{}
+	if err := json.Unmarshal([]byte(item.AttributeJSON), &generic); err != nil {
+		m.logger.Error("Failed to parse item: %v", err)
+		return nil
+	}
+	pretty, err := json.MarshalIndent(generic, "", "  ")
+	if err != nil {
+		return nil
+	}
+
+	m.itemEditOriginal = item.AttributeJSON
+	m.editorPurpose = editorPurposeItemEdit
+	m.structuredEditor.SetFormat(components.FormatJSON, false)
+	m.structuredEditor.SetSize(m.width, m.height)
+	return m.structuredEditor.Activate("Edit item (AttributeValue JSON): "+m.state.SelectedTable.Name, string(pretty))
+}
+
+// handleItemEditor diffs the edited item against the original and sends only
+// the changed attributes, refusing key attribute changes.
+func (m *Model) handleItemEditor(msg tea.Msg) tea.Cmd {
+	result, cmd := m.structuredEditor.Update(msg)
+	if result == nil {
+		return cmd
+	}
+	if result.Cancelled {
+		m.itemEditOriginal = ""
+		return nil
+	}
+
+	table := m.state.SelectedTable
+	if table == nil {
+		return nil
+	}
+	pkName, skName := table.PartitionKey(), table.SortKey()
+
+	var oldAttrs, newAttrs map[string]interface{}
+	if err := json.Unmarshal([]byte(m.itemEditOriginal), &oldAttrs); err != nil {
+		m.logger.Error("Failed to parse original item: %v", err)
+		return nil
+	}
+	if err := json.Unmarshal([]byte(result.Value), &newAttrs); err != nil {
+		m.logger.Error("Edited item is not valid JSON: %v", err)
+		return nil
+	}
+	m.itemEditOriginal = ""
+
+	// Only send what actually changed, and never the key attributes -
+	// DynamoDB cannot update those in place
+	changed := make(map[string]interface{})
+	for attr, value := range newAttrs {
+		if reflect.DeepEqual(oldAttrs[attr], value) {
+			continue
+		}
+		if attr == pkName || attr == skName {
+			m.logger.Error("Key attribute %s cannot be updated in place - create a new item instead", attr)
+			return nil
+		}
+		changed[attr] = value
+	}
+	for attr := range oldAttrs {
+		if _, ok := newAttrs[attr]; !ok {
+			m.logger.Warn("Attribute %s was removed in the editor - removal isn't supported, leaving it unchanged", attr)
+		}
+	}
+	if len(changed) == 0 {
+		m.logger.Info("No attribute changes to apply")
+		return nil
+	}
+
+	// Convert the changed attributes and the key back to SDK values
+	updates, err := marshalGenericAttributes(changed)
+	if err != nil {
+		m.logger.Error("Invalid attribute value: %v", err)
+		return nil
+	}
+	keyGeneric := map[string]interface{}{pkName: oldAttrs[pkName]}
+	if skName != "" {
+		keyGeneric[skName] = oldAttrs[skName]
+	}
+	key, err := marshalGenericAttributes(keyGeneric)
+	if err != nil {
+		m.logger.Error("Invalid key value: %v", err)
+		return nil
+	}
+
+	tableName := table.Name
+	m.logger.Info("Updating %d attribute(s)...", len(changed))
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		err := m.client.UpdateItem(ctx, tableName, key, updates)
+		return itemUpdatedMsg{table: tableName, err: err}
+	}
+}
+
+// marshalGenericAttributes converts generic wire-format attribute values
+// (as produced by json.Unmarshal of AttributeValue JSON) into SDK values.
+func marshalGenericAttributes(attrs map[string]interface{}) (map[string]dbtypes.AttributeValue, error) {
+	data, err := json.Marshal(attrs)
+	if err != nil {
+		return nil, err
+	}
+	return aws.UnmarshalAttributeValueJSON(string(data))
+}
+
+// handleItemUpdated reports the outcome of an item update.
+func (m *Model) handleItemUpdated(msg itemUpdatedMsg) {
+	if msg.err != nil {
+		m.logger.Error("Failed to update item: %v", msg.err)
+		m.state.ShowLogs = true
+		m.updateComponentSizes()
+		return
+	}
+	m.logger.Info("Item updated - press r to refresh the results")
+}
@@ -1,6 +1,13 @@
 package ui
 
-import "github.com/charmbracelet/bubbles/key"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+
+	"vaws/internal/config"
+)
 
 // KeyMap defines all keybindings for the application.
 type KeyMap struct {
@@ -156,6 +163,173 @@ func DefaultKeyMap() KeyMap {
 	}
 }
 
+// BindingGroup is a named set of keybindings for the exportable reference.
+type BindingGroup struct {
+	Name     string
+	Bindings []key.Binding
+}
+
+// ReferenceGroups returns every keybinding grouped by the view or mode it
+// applies to. It is built from the registered bindings (plus the literal
+// quick keys handled outside the KeyMap) so exports stay in sync as
+// bindings change.
+func (k KeyMap) ReferenceGroups() []BindingGroup {
+	return []BindingGroup{
+		{
+			Name:     "Navigation",
+			Bindings: []key.Binding{k.Up, k.Down, k.Left, k.Right, k.Enter, k.Back, k.Top, k.Bottom},
+		},
+		{
+			Name: "Views",
+			Bindings: []key.Binding{
+				key.NewBinding(key.WithKeys("0"), key.WithHelp("0", "main menu")),
+				key.NewBinding(key.WithKeys("1"), key.WithHelp("1", "ECS clusters")),
+				key.NewBinding(key.WithKeys("2"), key.WithHelp("2", "Lambda functions")),
+				key.NewBinding(key.WithKeys("3"), key.WithHelp("3", "SQS queues")),
+				key.NewBinding(key.WithKeys("4"), key.WithHelp("4", "DynamoDB tables")),
+				key.NewBinding(key.WithKeys("5"), key.WithHelp("5", "API Gateway")),
+				key.NewBinding(key.WithKeys("6"), key.WithHelp("6", "CloudFormation stacks")),
+				k.Tunnels,
+			},
+		},
+		{
+			Name:     "Actions",
+			Bindings: []key.Binding{k.Refresh, k.Filter, k.Logs, k.CloudWatchLogs, k.PortForward, k.LambdaInvoke, k.Help, k.Quit},
+		},
+		{
+			Name: "Lambda view",
+			Bindings: []key.Binding{
+				key.NewBinding(key.WithKeys("o"), key.WithHelp("o", "cycle grouping")),
+				key.NewBinding(key.WithKeys("enter", " "), key.WithHelp("enter/space", "toggle group collapse")),
+			},
+		},
+		{
+			Name: "DynamoDB view",
+			Bindings: []key.Binding{
+				key.NewBinding(key.WithKeys("q"), key.WithHelp("q", "query table")),
+				key.NewBinding(key.WithKeys("s"), key.WithHelp("s", "scan table")),
+			},
+		},
+		{
+			Name:     "Tunnels view",
+			Bindings: []key.Binding{k.StopTunnel, k.RestartTunnel, k.ClearTunnels},
+		},
+		{
+			Name:     "Logs panel",
+			Bindings: []key.Binding{k.LogScrollUp, k.LogScrollDown, k.LogScrollEnd},
+		},
+		{
+			Name:     "Filter mode",
+			Bindings: []key.Binding{k.FilterAccept, k.FilterClear},
+		},
+		{
+			Name:     "Copy / clipboard",
+			Bindings: []key.Binding{k.CopyMode, k.YankClipboard},
+		},
+	}
+}
+
+// ApplyConfig overlays the configured keymap onto the defaults: the preset
+// first, then per-action bindings. Unknown actions are returned as errors so
+// typos don't silently do nothing.
+func (k *KeyMap) ApplyConfig(cfg config.KeymapConfig) []error {
+	if cfg.Preset == "vim" {
+		k.applyVimPreset()
+	}
+
+	var errs []error
+	for action, keys := range cfg.Bindings {
+		if len(keys) == 0 {
+			continue // Validate reports this
+		}
+		binding := k.bindingFor(action)
+		if binding == nil {
+			errs = append(errs, fmt.Errorf("unknown action %q", action))
+			continue
+		}
+		*binding = key.NewBinding(
+			key.WithKeys(keys...),
+			key.WithHelp(strings.Join(keys, "/"), action),
+		)
+	}
+	return errs
+}
+
+// bindingFor maps a config action name to its binding.
+func (k *KeyMap) bindingFor(action string) *key.Binding {
+	switch action {
+	case "up":
+		return &k.Up
+	case "down":
+		return &k.Down
+	case "left":
+		return &k.Left
+	case "right":
+		return &k.Right
+	case "select":
+		return &k.Enter
+	case "back":
+		return &k.Back
+	case "top":
+		return &k.Top
+	case "bottom":
+		return &k.Bottom
+	case "refresh":
+		return &k.Refresh
+	case "filter":
+		return &k.Filter
+	case "logs":
+		return &k.Logs
+	case "help":
+		return &k.Help
+	case "quit":
+		return &k.Quit
+	}
+	return nil
+}
+
+// applyVimPreset maps the classic vim motions onto navigation. h/j/k/g/G
+// are already in the defaults; the preset adds l as select. Note that l
+// then shadows the logs toggle - vim users reach logs via :logs.
+func (k *KeyMap) applyVimPreset() {
+	k.Left = key.NewBinding(
+		key.WithKeys("left", "h"),
+		key.WithHelp("h", "back"),
+	)
+	k.Right = key.NewBinding(
+		key.WithKeys("right", "l"),
+		key.WithHelp("l", "select"),
+	)
+}
+
+// applyKeymapConfig overlays the config keymap onto the model's bindings,
+// logging any unknown actions.
+func (m *Model) applyKeymapConfig() {
+	if m.cfg == nil {
+		return
+	}
+	for _, err := range m.keys.ApplyConfig(m.cfg.Keymap) {
+		m.logger.Warn("keymap: %v", err)
+	}
+}
+
+// RenderMarkdown renders the full keybinding reference as Markdown,
+// suitable for keeping open outside the TUI.
+func (k KeyMap) RenderMarkdown() string {
+	var b strings.Builder
+	b.WriteString("# vaws keybindings\n")
+	for _, group := range k.ReferenceGroups() {
+		b.WriteString("\n## " + group.Name + "\n\n")
+		b.WriteString("| Key | Action |\n")
+		b.WriteString("|-----|--------|\n")
+		for _, binding := range group.Bindings {
+			h := binding.Help()
+			b.WriteString(fmt.Sprintf("| `%s` | %s |\n", h.Key, h.Desc))
+		}
+	}
+	return b.String()
+}
+
 // ShortHelp returns keybindings for the short help view.
 func (k KeyMap) ShortHelp() []key.Binding {
 	return []key.Binding{k.Up, k.Down, k.Enter, k.Back, k.Filter, k.Logs, k.Quit}
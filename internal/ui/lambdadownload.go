@@ -0,0 +1,115 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// downloadFunctionCode fetches the selected function's deployment package
+// (presigned S3 URL) and saves it under ~/.vaws/lambda-code. Image-based
+// functions have no zip: their ECR image URI is copied instead.
+func (m *Model) downloadFunctionCode() tea.Cmd {
+	item := m.lambdaList.SelectedItem()
+	if item == nil {
+		return nil
+	}
+
+	for i := range m.state.Functions {
+		if m.state.Functions[i].Name != item.ID {
+			continue
+		}
+		fn := m.state.Functions[i]
+		name := fn.Name
+		isImage := fn.PackageType == "Image"
+
+		if isImage {
+			m.logger.Info("Resolving image URI for %s...", name)
+		} else {
+			m.logger.Info("Downloading deployment package for %s...", name)
+		}
+
+		return func() tea.Msg {
+			ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
+			defer cancel()
+
+			location, err := m.client.GetFunctionCodeLocation(ctx, name)
+			if err != nil {
+				return fnCodeDownloadedMsg{name: name, err: err}
+			}
+			if isImage {
+				return fnCodeDownloadedMsg{name: name, imageURI: location}
+			}
+
+			path, size, err := downloadToFile(ctx, location, name+".zip")
+			return fnCodeDownloadedMsg{name: name, path: path, size: size, err: err}
+		}
+	}
+	return nil
+}
+
+// downloadToFile streams a presigned URL into ~/.vaws/lambda-code/<file>.
+func downloadToFile(ctx context.Context, url, filename string) (string, int64, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to resolve home directory: %w", err)
+	}
+	dir := filepath.Join(home, ".vaws", "lambda-code")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", 0, fmt.Errorf("failed to create %s: %w", dir, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", 0, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to download package: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", 0, fmt.Errorf("download failed with status %s", resp.Status)
+	}
+
+	path := filepath.Join(dir, filename)
+	file, err := os.Create(path)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to create %s: %w", path, err)
+	}
+	defer file.Close()
+
+	size, err := io.Copy(file, resp.Body)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return path, size, nil
+}
+
+// handleFnCodeDownloaded reports the download result, copying the image URI
+// for image-based functions.
+func (m *Model) handleFnCodeDownloaded(msg fnCodeDownloadedMsg) {
+	if msg.err != nil {
+		m.logger.Error("Failed to fetch code for %s: %v", msg.name, msg.err)
+		m.state.ShowLogs = true
+		m.updateComponentSizes()
+		return
+	}
+
+	if msg.imageURI != "" {
+		if err := copyToClipboard(msg.imageURI); err != nil {
+			m.logger.Warn("Clipboard not available - image URI: %s", msg.imageURI)
+			return
+		}
+		m.logger.Info("Image-based function - copied image URI to clipboard: %s", msg.imageURI)
+		return
+	}
+
+	m.logger.Info("Downloaded %s package to %s (%s)", msg.name, msg.path, formatBytes(msg.size))
+}
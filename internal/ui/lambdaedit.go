@@ -0,0 +1,152 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"vaws/internal/ui/theme"
+)
+
+// Lambda config edit stages and AWS limits.
+const (
+	lambdaEditStageNone = iota
+	lambdaEditStageMemory
+	lambdaEditStageTimeout
+
+	lambdaMemoryMin  = 128
+	lambdaMemoryMax  = 10240
+	lambdaTimeoutMin = 1
+	lambdaTimeoutMax = 900
+)
+
+// startLambdaEdit opens the memory/timeout edit form for the selected function.
+func (m *Model) startLambdaEdit() tea.Cmd {
+	item := m.lambdaList.SelectedItem()
+	if item == nil {
+		return nil
+	}
+
+	for i := range m.state.Functions {
+		if m.state.Functions[i].Name != item.ID {
+			continue
+		}
+		fn := m.state.Functions[i]
+		m.lambdaEditFn = &fn
+		m.lambdaEditStage = lambdaEditStageMemory
+		m.lambdaEditInput.SetValue(strconv.Itoa(fn.MemorySize))
+		m.lambdaEditInput.Focus()
+		return textinput.Blink
+	}
+	return nil
+}
+
+// handleLambdaEditKey handles the memory and timeout prompts.
+func (m *Model) handleLambdaEditKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "enter":
+		value, err := strconv.Atoi(m.lambdaEditInput.Value())
+		switch m.lambdaEditStage {
+		case lambdaEditStageMemory:
+			if err != nil || value < lambdaMemoryMin || value > lambdaMemoryMax {
+				m.logger.Error("Memory must be %d-%d MB", lambdaMemoryMin, lambdaMemoryMax)
+				return nil
+			}
+			m.lambdaEditMemory = value
+			m.lambdaEditStage = lambdaEditStageTimeout
+			m.lambdaEditInput.SetValue(strconv.Itoa(m.lambdaEditFn.Timeout))
+			return textinput.Blink
+
+		case lambdaEditStageTimeout:
+			if err != nil || value < lambdaTimeoutMin || value > lambdaTimeoutMax {
+				m.logger.Error("Timeout must be %d-%d seconds", lambdaTimeoutMin, lambdaTimeoutMax)
+				return nil
+			}
+			fn := m.lambdaEditFn
+			memory := m.lambdaEditMemory
+			timeout := value
+
+			m.lambdaEditStage = lambdaEditStageNone
+			m.lambdaEditFn = nil
+			m.lambdaEditInput.Blur()
+
+			if fn == nil {
+				return nil
+			}
+			name := fn.Name
+			m.logger.Info("Updating %s to %d MB / %ds...", name, memory, timeout)
+
+			return func() tea.Msg {
+				ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+				defer cancel()
+
+				updated, err := m.client.UpdateFunctionConfig(ctx, name, memory, timeout)
+				return fnConfigUpdatedMsg{name: name, fn: updated, err: err}
+			}
+		}
+		return nil
+
+	case "esc":
+		m.lambdaEditStage = lambdaEditStageNone
+		m.lambdaEditFn = nil
+		m.lambdaEditInput.Blur()
+		return nil
+	}
+
+	var cmd tea.Cmd
+	m.lambdaEditInput, cmd = m.lambdaEditInput.Update(msg)
+	return cmd
+}
+
+// renderLambdaEditDialog renders the memory/timeout edit form.
+func (m *Model) renderLambdaEditDialog() string {
+	fn := m.lambdaEditFn
+	if fn == nil {
+		return ""
+	}
+
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.BorderFocus).
+		Padding(1, 2).
+		Width(50)
+
+	labelStyle := lipgloss.NewStyle().Foreground(theme.Primary).Bold(true)
+	hintStyle := lipgloss.NewStyle().Foreground(theme.TextDim).Italic(true)
+
+	field := fmt.Sprintf("Memory (MB, %d-%d)", lambdaMemoryMin, lambdaMemoryMax)
+	hint := fmt.Sprintf("Current: %d MB", fn.MemorySize)
+	if m.lambdaEditStage == lambdaEditStageTimeout {
+		field = fmt.Sprintf("Timeout (s, %d-%d)", lambdaTimeoutMin, lambdaTimeoutMax)
+		hint = fmt.Sprintf("Current: %ds", fn.Timeout)
+	}
+
+	return dialogStyle.Render(
+		labelStyle.Render("Edit: "+fn.Name) + "\n\n" +
+			field + ": " + m.lambdaEditInput.View() + "\n\n" +
+			hintStyle.Render(hint+" • Enter next • Esc cancel"))
+}
+
+// handleFnConfigUpdated applies a completed config update.
+func (m *Model) handleFnConfigUpdated(msg fnConfigUpdatedMsg) {
+	if msg.err != nil {
+		m.logger.Error("Failed to update %s: %v", msg.name, msg.err)
+		m.state.ShowLogs = true
+		m.updateComponentSizes()
+		return
+	}
+
+	for i := range m.state.Functions {
+		if m.state.Functions[i].Name == msg.name {
+			m.state.Functions[i] = *msg.fn
+			break
+		}
+	}
+	m.logger.Info("%s updated: %d MB / %ds", msg.name, msg.fn.MemorySize, msg.fn.Timeout)
+	m.updateLambdaList()
+}
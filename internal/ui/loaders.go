@@ -2,36 +2,65 @@ package ui
 
 import (
 	"context"
+	"errors"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
 	tea "github.com/charmbracelet/bubbletea"
 
+	"vaws/internal/aws"
+	"vaws/internal/config"
 	"vaws/internal/model"
 )
 
+// defaultLogFetchLimit is the per-fetch CloudWatch entry limit before any
+// timeout-driven reduction.
+const defaultLogFetchLimit int32 = 100
+
+// logFetchTimeout returns the configurable per-fetch CloudWatch timeout.
+func (m *Model) logFetchTimeout() time.Duration {
+	if m.cfg != nil {
+		return m.cfg.LogFetchTimeout()
+	}
+	return config.DefaultLogFetchTimeout
+}
+
+// currentLogFetchLimit returns the per-fetch entry limit, reduced after a
+// timed-out fetch.
+func (m *Model) currentLogFetchLimit() int32 {
+	if m.cloudWatchFetchLimit <= 0 {
+		return defaultLogFetchLimit
+	}
+	return m.cloudWatchFetchLimit
+}
+
 // fetchCloudWatchLogs fetches CloudWatch logs for the selected container.
 func (m *Model) fetchCloudWatchLogs() tea.Cmd {
-	config := m.cloudWatchLogsPanel.SelectedContainer()
-	if config == nil {
+	logConfig := m.cloudWatchLogsPanel.SelectedContainer()
+	if logConfig == nil {
 		return nil
 	}
 
 	startTime := m.state.CloudWatchLastFetchTime
+	timeout := m.logFetchTimeout()
+	limit := m.currentLogFetchLimit()
+	pattern := m.cwServerPattern
 
 	return func() tea.Msg {
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
 		defer cancel()
 
-		entries, lastTimestamp, err := m.client.FetchLogs(
+		entries, lastTimestamp, err := m.client.FetchLogsFiltered(
 			ctx,
-			config.LogGroup,
-			config.LogStreamName,
+			logConfig.LogGroup,
+			logConfig.LogStreamName,
+			pattern,
 			startTime,
-			100, // Limit per fetch
+			limit,
 		)
 
 		return cloudWatchLogsLoadedMsg{
@@ -45,16 +74,20 @@ func (m *Model) fetchCloudWatchLogs() tea.Cmd {
 // fetchLambdaCloudWatchLogs fetches CloudWatch logs for a Lambda function.
 func (m *Model) fetchLambdaCloudWatchLogs(logGroup string) tea.Cmd {
 	startTime := m.state.CloudWatchLastFetchTime
+	timeout := m.logFetchTimeout()
+	limit := m.currentLogFetchLimit()
+	pattern := m.cwServerPattern
 
 	return func() tea.Msg {
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
 		defer cancel()
 
-		entries, lastTimestamp, err := m.client.FetchLambdaLogs(
+		entries, lastTimestamp, err := m.client.FetchLambdaLogsFiltered(
 			ctx,
 			logGroup,
+			pattern,
 			startTime,
-			100, // Limit per fetch
+			limit,
 		)
 
 		return cloudWatchLogsLoadedMsg{
@@ -65,6 +98,164 @@ func (m *Model) fetchLambdaCloudWatchLogs(logGroup string) tea.Cmd {
 	}
 }
 
+// maxConcurrentCountCalls limits concurrent per-stack resource count calls.
+const maxConcurrentCountCalls = 5
+
+// loadStackCounts computes per-stack resource counts in the background,
+// streaming results so badges populate as they resolve. Stacks already
+// counted this session are skipped.
+func (m *Model) loadStackCounts(stacks []model.Stack) tea.Cmd {
+	if m.stackCountsChan != nil {
+		return nil // a counting pass is already in flight
+	}
+
+	var todo []string
+	for _, s := range stacks {
+		if _, ok := m.stackCounts[s.Name]; !ok {
+			todo = append(todo, s.Name)
+		}
+	}
+	if len(todo) == 0 {
+		return nil
+	}
+
+	resultChan := make(chan stackCountsMsg, len(todo))
+
+	go func() {
+		defer close(resultChan)
+		ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
+		defer cancel()
+
+		sem := make(chan struct{}, maxConcurrentCountCalls)
+		var wg sync.WaitGroup
+		for _, name := range todo {
+			wg.Add(1)
+			go func(stackName string) {
+				defer wg.Done()
+				sem <- struct{}{}
+				defer func() { <-sem }()
+
+				counts, err := m.client.CountStackResources(ctx, stackName)
+				if err != nil {
+					return // badge simply stays absent
+				}
+				resultChan <- stackCountsMsg{stack: stackName, counts: counts}
+			}(name)
+		}
+		wg.Wait()
+	}()
+
+	m.stackCountsChan = resultChan
+	return func() tea.Msg {
+		msg, ok := <-resultChan
+		if !ok {
+			return nil
+		}
+		return msg
+	}
+}
+
+// continueStackCounts continues reading from the stack counts channel.
+func (m *Model) continueStackCounts() tea.Cmd {
+	if m.stackCountsChan == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		msg, ok := <-m.stackCountsChan
+		if !ok {
+			m.stackCountsChan = nil
+			return nil
+		}
+		return msg
+	}
+}
+
+// maxConcurrentTagCalls limits concurrent per-function tag lookups.
+const maxConcurrentTagCalls = 5
+
+// loadFunctionTags enriches the loaded functions with resource tags in the
+// background, streaming results like the stack-count badges. The flat
+// ListFunctions path carries no tags, so without this pass stack grouping
+// would put every function into "(no stack)". Functions whose tags were
+// already fetched are skipped.
+func (m *Model) loadFunctionTags() tea.Cmd {
+	if m.fnTagsChan != nil {
+		return nil // an enrichment pass is already in flight
+	}
+
+	type target struct{ name, arn string }
+	var todo []target
+	for i := range m.state.Functions {
+		fn := &m.state.Functions[i]
+		if fn.Tags == nil && fn.ARN != "" {
+			todo = append(todo, target{name: fn.Name, arn: fn.ARN})
+		}
+	}
+	if len(todo) == 0 {
+		return nil
+	}
+
+	m.logger.Info("Fetching tags for %d function(s) to group by stack...", len(todo))
+	resultChan := make(chan fnTagsMsg, len(todo))
+	gen := m.loadGeneration
+
+	go func() {
+		defer close(resultChan)
+		ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
+		defer cancel()
+
+		sem := make(chan struct{}, maxConcurrentTagCalls)
+		var wg sync.WaitGroup
+		for _, t := range todo {
+			wg.Add(1)
+			go func(name, arn string) {
+				defer wg.Done()
+				sem <- struct{}{}
+				defer func() { <-sem }()
+
+				var tags map[string]string
+				err := aws.WithRetry(ctx, func() error {
+					var err error
+					tags, err = m.client.GetFunctionTags(ctx, arn)
+					return err
+				})
+				if err != nil {
+					return // the function just stays in "(no stack)"
+				}
+				if tags == nil {
+					tags = map[string]string{} // fetched, just untagged
+				}
+				resultChan <- fnTagsMsg{name: name, tags: tags, gen: gen}
+			}(t.name, t.arn)
+		}
+		wg.Wait()
+	}()
+
+	m.fnTagsChan = resultChan
+	return func() tea.Msg {
+		msg, ok := <-resultChan
+		if !ok {
+			return nil
+		}
+		return msg
+	}
+}
+
+// continueFunctionTags continues reading from the tag enrichment channel.
+func (m *Model) continueFunctionTags() tea.Cmd {
+	if m.fnTagsChan == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		msg, ok := <-m.fnTagsChan
+		if !ok {
+			m.fnTagsChan = nil
+			return nil
+		}
+		return msg
+	}
+}
+
 // loadStacks loads CloudFormation stacks.
 func (m *Model) loadStacks() tea.Cmd {
 	m.state.StacksLoading = true
@@ -128,8 +319,14 @@ func (m *Model) loadServicesForCluster() tea.Cmd {
 	)
 }
 
-// loadFunctions loads Lambda functions with lazy loading.
+// loadFunctions loads Lambda functions with lazy loading. A load already in
+// flight is left alone: overlapping streams would duplicate appended rows
+// since the isAppend logic assumes a single producer.
 func (m *Model) loadFunctions() tea.Cmd {
+	if m.state.FunctionsLoading {
+		m.logger.Debug("Lambda load already in flight - ignoring retrigger")
+		return nil
+	}
 	m.state.FunctionsLoading = true
 	m.lambdaList.SetLoading(true)
 
@@ -144,10 +341,14 @@ func (m *Model) loadFunctions() tea.Cmd {
 
 	// Use channel to receive incremental results
 	resultChan := make(chan functionsLoadedMsg, 10)
+	gen := m.loadGeneration
+
+	// Cancelable so Esc can stop pagination mid-stream
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	m.functionsLoadCancel = cancel
 
 	// Start background loading
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
 		defer cancel()
 		defer close(resultChan)
 
@@ -155,45 +356,61 @@ func (m *Model) loadFunctions() tea.Cmd {
 			// Stack-specific loading (no pagination needed, usually small)
 			functionNames, err := m.client.GetLambdaFunctionsFromStack(ctx, stackName)
 			if err != nil {
-				resultChan <- functionsLoadedMsg{functions: nil, err: err}
+				if !errors.Is(err, context.Canceled) {
+					resultChan <- functionsLoadedMsg{functions: nil, err: err, gen: gen}
+				}
 				return
 			}
 
 			if len(functionNames) == 0 {
-				resultChan <- functionsLoadedMsg{functions: []model.Function{}, err: nil}
+				resultChan <- functionsLoadedMsg{functions: []model.Function{}, err: nil, gen: gen}
 				return
 			}
 
 			var functions []model.Function
 			for _, name := range functionNames {
-				fn, err := m.client.DescribeFunction(ctx, name)
+				// Retry throttled describes so functions don't drop out
+				var fn *model.Function
+				err := aws.WithRetry(ctx, func() error {
+					var err error
+					fn, err = m.client.DescribeFunction(ctx, name)
+					return err
+				})
 				if err != nil {
 					continue
 				}
 				functions = append(functions, *fn)
 			}
-			resultChan <- functionsLoadedMsg{functions: functions, err: nil}
+			resultChan <- functionsLoadedMsg{functions: functions, err: nil, gen: gen}
 			return
 		}
 
 		// Lazy load with incremental results
 		isFirst := true
 		err := m.client.ListFunctionsPagedCallback(ctx, func(functions []model.Function, hasMore bool) bool {
-			resultChan <- functionsLoadedMsg{
+			select {
+			case resultChan <- functionsLoadedMsg{
 				functions: functions,
 				err:       nil,
 				hasMore:   hasMore,
 				isAppend:  !isFirst,
+				gen:       gen,
+			}:
+			case <-ctx.Done():
+				return false // canceled - stop paginating
 			}
 			isFirst = false
 			return true // continue loading
 		})
-		if err != nil {
-			resultChan <- functionsLoadedMsg{functions: nil, err: err}
+		if err != nil && !errors.Is(err, context.Canceled) {
+			resultChan <- functionsLoadedMsg{functions: nil, err: err, gen: gen}
 		}
 	}()
 
-	// Return command that reads from channel
+	// Register the stream before returning so continue reads always use
+	// the latest channel
+	m.functionsResultChan = resultChan
+
 	return tea.Batch(
 		m.lambdaList.Spinner().TickCmd(),
 		func() tea.Msg {
@@ -201,8 +418,6 @@ func (m *Model) loadFunctions() tea.Cmd {
 			if !ok {
 				return nil
 			}
-			// Store channel for subsequent reads
-			m.functionsResultChan = resultChan
 			return msg
 		},
 	)
@@ -322,8 +537,13 @@ func (m *Model) loadEC2Instances() tea.Cmd {
 	)
 }
 
-// loadQueues loads SQS queues with lazy loading.
+// loadQueues loads SQS queues with lazy loading. Retriggers while a load is
+// in flight are ignored so only one result stream exists at a time.
 func (m *Model) loadQueues() tea.Cmd {
+	if m.state.QueuesLoading {
+		m.logger.Debug("Queue load already in flight - ignoring retrigger")
+		return nil
+	}
 	m.state.QueuesLoading = true
 	m.sqsTable.SetLoading(true)
 
@@ -338,10 +558,14 @@ func (m *Model) loadQueues() tea.Cmd {
 
 	// Use channel for incremental results
 	resultChan := make(chan queuesLoadedMsg, 10)
+	gen := m.loadGeneration
+
+	// Cancelable so Esc can stop pagination mid-stream
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	m.queuesLoadCancel = cancel
 
 	// Start background loading
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
 		defer cancel()
 		defer close(resultChan)
 
@@ -349,12 +573,14 @@ func (m *Model) loadQueues() tea.Cmd {
 			// Stack-specific loading (no pagination, usually small)
 			queueURLs, err := m.client.GetQueuesFromStack(ctx, stackName)
 			if err != nil {
-				resultChan <- queuesLoadedMsg{queues: nil, err: err}
+				if !errors.Is(err, context.Canceled) {
+					resultChan <- queuesLoadedMsg{queues: nil, err: err, gen: gen}
+				}
 				return
 			}
 
 			if len(queueURLs) == 0 {
-				resultChan <- queuesLoadedMsg{queues: []model.Queue{}, err: nil}
+				resultChan <- queuesLoadedMsg{queues: []model.Queue{}, err: nil, gen: gen}
 				return
 			}
 
@@ -371,28 +597,36 @@ func (m *Model) loadQueues() tea.Cmd {
 			// Fetch DLQ message counts
 			queues = m.enrichQueuesWithDLQ(ctx, queues)
 
-			resultChan <- queuesLoadedMsg{queues: queues, err: nil}
+			resultChan <- queuesLoadedMsg{queues: queues, err: nil, gen: gen}
 			return
 		}
 
 		// Lazy load with incremental results
 		isFirst := true
 		err := m.client.ListQueuesPagedCallback(ctx, func(queues []model.Queue, hasMore bool) bool {
-			resultChan <- queuesLoadedMsg{
+			select {
+			case resultChan <- queuesLoadedMsg{
 				queues:   queues,
 				err:      nil,
 				hasMore:  hasMore,
 				isAppend: !isFirst,
+				gen:      gen,
+			}:
+			case <-ctx.Done():
+				return false // canceled - stop paginating
 			}
 			isFirst = false
 			return true // continue loading
 		})
-		if err != nil {
-			resultChan <- queuesLoadedMsg{queues: nil, err: err}
+		if err != nil && !errors.Is(err, context.Canceled) {
+			resultChan <- queuesLoadedMsg{queues: nil, err: err, gen: gen}
 		}
 	}()
 
-	// Return command that reads from channel
+	// Register the stream before returning so continue reads always use
+	// the latest channel
+	m.queuesResultChan = resultChan
+
 	return tea.Batch(
 		m.sqsTable.Spinner().TickCmd(),
 		func() tea.Msg {
@@ -400,8 +634,6 @@ func (m *Model) loadQueues() tea.Cmd {
 			if !ok {
 				return nil
 			}
-			// Store channel for subsequent reads
-			m.queuesResultChan = resultChan
 			return msg
 		},
 	)
@@ -501,6 +733,27 @@ func (m *Model) loadAPIStages() tea.Cmd {
 	)
 }
 
+// loadAPIRoutes loads routes for the selected v2 API (HTTP or WebSocket).
+func (m *Model) loadAPIRoutes() tea.Cmd {
+	if m.state.SelectedHttpAPI == nil {
+		return nil
+	}
+	m.state.APIRoutesLoading = true
+	m.apiRoutesList.SetLoading(true)
+	apiID := m.state.SelectedHttpAPI.ID
+	m.logger.Info("Loading routes for API: %s", m.state.SelectedHttpAPI.Name)
+
+	return tea.Batch(
+		m.apiRoutesList.Spinner().TickCmd(),
+		func() tea.Msg {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			routes, err := m.client.GetHttpAPIRoutes(ctx, apiID)
+			return apiRoutesLoadedMsg{routes: routes, err: err}
+		},
+	)
+}
+
 // loadClusters loads ECS clusters.
 func (m *Model) loadClusters() tea.Cmd {
 	m.state.ClustersLoading = true
@@ -518,39 +771,56 @@ func (m *Model) loadClusters() tea.Cmd {
 	)
 }
 
-// loadTables loads DynamoDB tables with lazy loading.
+// loadTables loads DynamoDB tables with lazy loading. Retriggers while a
+// load is in flight are ignored so only one result stream exists at a time.
 func (m *Model) loadTables() tea.Cmd {
+	if m.state.TablesLoading {
+		m.logger.Debug("Table load already in flight - ignoring retrigger")
+		return nil
+	}
 	m.state.TablesLoading = true
 	m.dynamodbTable.SetLoading(true)
 	m.logger.Info("Loading DynamoDB tables...")
 
 	// Use channel for incremental results
 	resultChan := make(chan tablesLoadedMsg, 10)
+	gen := m.loadGeneration
+
+	// Cancelable so Esc can stop pagination mid-stream
+	ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
+	m.tablesLoadCancel = cancel
 
 	// Start background loading
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
 		defer cancel()
 		defer close(resultChan)
 
 		// Lazy load with incremental results
 		isFirst := true
 		err := m.client.ListTablesPagedCallback(ctx, func(tables []model.Table, hasMore bool) bool {
-			resultChan <- tablesLoadedMsg{
+			select {
+			case resultChan <- tablesLoadedMsg{
 				tables:   tables,
 				err:      nil,
 				hasMore:  hasMore,
 				isAppend: !isFirst,
+				gen:      gen,
+			}:
+			case <-ctx.Done():
+				return false // canceled - stop paginating
 			}
 			isFirst = false
 			return true // continue loading
 		})
-		if err != nil {
-			resultChan <- tablesLoadedMsg{tables: nil, err: err}
+		if err != nil && !errors.Is(err, context.Canceled) {
+			resultChan <- tablesLoadedMsg{tables: nil, err: err, gen: gen}
 		}
 	}()
 
-	// Return command that reads from channel
+	// Register the stream before returning so continue reads always use
+	// the latest channel
+	m.tablesResultChan = resultChan
+
 	return tea.Batch(
 		m.dynamodbTable.Spinner().TickCmd(),
 		func() tea.Msg {
@@ -558,8 +828,6 @@ func (m *Model) loadTables() tea.Cmd {
 			if !ok {
 				return nil
 			}
-			// Store channel for subsequent reads
-			m.tablesResultChan = resultChan
 			return msg
 		},
 	)
@@ -580,8 +848,57 @@ func (m *Model) continueTablesLoad() tea.Cmd {
 	}
 }
 
+// cancelFunctionsLoad stops an in-flight Lambda lazy load.
+func (m *Model) cancelFunctionsLoad() {
+	if m.functionsLoadCancel == nil || !m.state.FunctionsLoading {
+		return
+	}
+	m.functionsLoadCancel()
+	m.functionsLoadCancel = nil
+	m.state.FunctionsLoading = false
+	m.lambdaList.SetLoading(false)
+	m.refreshIndicator.SetRefreshing(false)
+	m.logger.Info("Cancelled Lambda function load")
+}
+
+// cancelQueuesLoad stops an in-flight SQS lazy load.
+func (m *Model) cancelQueuesLoad() {
+	if m.queuesLoadCancel == nil || !m.state.QueuesLoading {
+		return
+	}
+	m.queuesLoadCancel()
+	m.queuesLoadCancel = nil
+	m.state.QueuesLoading = false
+	m.sqsTable.SetLoading(false)
+	m.refreshIndicator.SetRefreshing(false)
+	m.logger.Info("Cancelled SQS queue load")
+}
+
+// cancelTablesLoad stops an in-flight DynamoDB lazy load.
+func (m *Model) cancelTablesLoad() {
+	if m.tablesLoadCancel == nil || !m.state.TablesLoading {
+		return
+	}
+	m.tablesLoadCancel()
+	m.tablesLoadCancel = nil
+	m.state.TablesLoading = false
+	m.dynamodbTable.SetLoading(false)
+	m.refreshIndicator.SetRefreshing(false)
+	m.logger.Info("Cancelled DynamoDB table load")
+}
+
+// cancelLazyLoads stops every in-flight lazy load, used when the profile or
+// region changes so background pagination stops consuming quota in the old
+// context.
+func (m *Model) cancelLazyLoads() {
+	m.cancelFunctionsLoad()
+	m.cancelQueuesLoad()
+	m.cancelTablesLoad()
+}
+
 // executeDynamoDBQuery executes a DynamoDB query.
 func (m *Model) executeDynamoDBQuery(params *model.QueryParams) tea.Cmd {
+	m.partiqlStatement = "" // the builder flow owns the results again
 	m.state.DynamoDBQueryLoading = true
 	m.state.DynamoDBQueryParams = params
 	m.state.DynamoDBIsQuery = true
@@ -598,6 +915,7 @@ func (m *Model) executeDynamoDBQuery(params *model.QueryParams) tea.Cmd {
 
 // executeDynamoDBScan executes a DynamoDB scan.
 func (m *Model) executeDynamoDBScan(params *model.ScanParams) tea.Cmd {
+	m.partiqlStatement = "" // the builder flow owns the results again
 	m.state.DynamoDBQueryLoading = true
 	m.state.DynamoDBScanParams = params
 	m.state.DynamoDBIsQuery = false
@@ -618,6 +936,13 @@ func (m *Model) loadNextDynamoDBPage() tea.Cmd {
 		return nil
 	}
 
+	// PartiQL results page via NextToken rather than ExclusiveStartKey
+	if m.partiqlStatement != "" {
+		token := m.state.DynamoDBQueryResult.NextToken
+		m.logger.Info("Loading next page of PartiQL results...")
+		return m.executePartiQL(m.partiqlStatement, &token)
+	}
+
 	m.state.DynamoDBLastKey = m.state.DynamoDBQueryResult.LastEvaluatedKey
 	m.state.DynamoDBQueryLoading = true
 	m.dynamodbQueryResults.SetLoading(true)
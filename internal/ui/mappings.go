@@ -0,0 +1,245 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"vaws/internal/state"
+	"vaws/internal/ui/components"
+	"vaws/internal/ui/theme"
+)
+
+// esmPollInterval paces state polls while a mapping toggle settles.
+const esmPollInterval = 2 * time.Second
+
+// esmMaxPolls bounds how long a settling mapping is watched (~1 minute).
+const esmMaxPolls = 30
+
+// esmPollTickMsg schedules the next mapping state poll.
+type esmPollTickMsg time.Time
+
+// loadEventSourceMappings fetches the selected function's event sources so
+// they render beneath its details.
+func (m *Model) loadEventSourceMappings() tea.Cmd {
+	item := m.lambdaList.SelectedItem()
+	if item == nil {
+		return nil
+	}
+	name := item.ID
+	m.logger.Info("Loading event source mappings for %s...", name)
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		mappings, err := m.client.ListEventSourceMappings(ctx, name)
+		return mappingsLoadedMsg{function: name, mappings: mappings, err: err}
+	}
+}
+
+// mappingDetailRows renders cached event source mappings for a function.
+func (m *Model) mappingDetailRows(functionName string) []components.DetailRow {
+	mappings, ok := m.fnMappings[functionName]
+	if !ok {
+		return nil
+	}
+
+	rows := []components.DetailRow{
+		{Label: "", Value: ""}, // Spacer
+	}
+	if len(mappings) == 0 {
+		rows = append(rows, components.DetailRow{
+			Label: "Triggers",
+			Value: "no event source mappings",
+			Style: lipgloss.NewStyle().Foreground(theme.TextDim),
+		})
+		return rows
+	}
+
+	rows = append(rows, components.DetailRow{Label: "Triggers", Value: fmt.Sprintf("%d", len(mappings))})
+	for _, esm := range mappings {
+		style := lipgloss.NewStyle().Foreground(theme.Success)
+		if esm.State != "Enabled" {
+			style = lipgloss.NewStyle().Foreground(theme.Warning)
+		}
+		value := fmt.Sprintf("%s (%s, batch %d", esm.SourceName(), esm.State, esm.BatchSize)
+		if esm.MaxBatchingWindowSeconds > 0 {
+			value += fmt.Sprintf(", window %ds", esm.MaxBatchingWindowSeconds)
+		}
+		value += ")"
+		rows = append(rows, components.DetailRow{
+			Label: "  source",
+			Value: value,
+			Style: style,
+		})
+	}
+	if m.firstSQSMapping(functionName) != "" {
+		rows = append(rows, components.DetailRow{
+			Label: "  hint",
+			Value: "J jumps to the triggering SQS queue",
+			Style: lipgloss.NewStyle().Foreground(theme.TextDim),
+		})
+	}
+	return rows
+}
+
+// firstSQSMapping returns the name of the first SQS event source that exists
+// in the loaded queues, or "" when none is navigable.
+func (m *Model) firstSQSMapping(functionName string) string {
+	for _, esm := range m.fnMappings[functionName] {
+		if !esm.IsSQS() {
+			continue
+		}
+		name := esm.SourceName()
+		for _, q := range m.state.Queues {
+			if q.Name == name {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+// startESMToggle opens the toggle prompt for the selected function's
+// mappings. The prompt doubles as the confirmation: it states the mapping
+// and the action that will run.
+func (m *Model) startESMToggle() tea.Cmd {
+	item := m.lambdaList.SelectedItem()
+	if item == nil {
+		return nil
+	}
+	mappings := m.fnMappings[item.ID]
+	if len(mappings) == 0 {
+		m.logger.Info("No mappings loaded for %s - press M first", item.ID)
+		return nil
+	}
+
+	m.esmToggleFn = item.ID
+	m.esmToggleActive = true
+	m.esmInput.SetValue("1")
+	m.esmInput.Focus()
+	return textinput.Blink
+}
+
+// handleESMToggleKey handles the mapping toggle prompt.
+func (m *Model) handleESMToggleKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "enter":
+		mappings := m.fnMappings[m.esmToggleFn]
+		idx, err := strconv.Atoi(m.esmInput.Value())
+		if err != nil || idx < 1 || idx > len(mappings) {
+			m.logger.Error("Pick a mapping number between 1 and %d", len(mappings))
+			return nil
+		}
+
+		target := mappings[idx-1]
+		enable := target.State != "Enabled" && target.State != "Enabling"
+		fn := m.esmToggleFn
+
+		m.esmToggleActive = false
+		m.esmInput.Blur()
+
+		action := "Disabling"
+		if enable {
+			action = "Enabling"
+		}
+		m.logger.Info("%s trigger %s for %s...", action, target.SourceName(), fn)
+
+		uuid := target.UUID
+		return func() tea.Msg {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			err := m.client.SetEventSourceMappingEnabled(ctx, uuid, enable)
+			return esmToggledMsg{function: fn, uuid: uuid, enabled: enable, err: err}
+		}
+
+	case "esc":
+		m.esmToggleActive = false
+		m.esmInput.Blur()
+		return nil
+	}
+
+	var cmd tea.Cmd
+	m.esmInput, cmd = m.esmInput.Update(msg)
+	return cmd
+}
+
+// renderESMToggleDialog renders the mapping toggle prompt.
+func (m *Model) renderESMToggleDialog() string {
+	mappings := m.fnMappings[m.esmToggleFn]
+
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.Warning).
+		Padding(1, 2).
+		Width(56)
+
+	titleStyle := lipgloss.NewStyle().Foreground(theme.Warning).Bold(true)
+	normalStyle := lipgloss.NewStyle().Foreground(theme.Text)
+	hintStyle := lipgloss.NewStyle().Foreground(theme.TextDim).Italic(true)
+
+	content := titleStyle.Render("Toggle trigger: "+m.esmToggleFn) + "\n\n"
+	for i, esm := range mappings {
+		content += normalStyle.Render(fmt.Sprintf("%d. %s (%s)", i+1, esm.SourceName(), esm.State)) + "\n"
+	}
+	content += "\n" + "Mapping #: " + m.esmInput.View() + "\n\n" +
+		hintStyle.Render("Enabled triggers are disabled and vice versa • Esc cancel")
+
+	return dialogStyle.Render(content)
+}
+
+// esmPollTick schedules the next poll of a settling mapping.
+func (m *Model) esmPollTick() tea.Cmd {
+	return tea.Tick(esmPollInterval, func(t time.Time) tea.Msg {
+		return esmPollTickMsg(t)
+	})
+}
+
+// pollESMState fetches the watched mapping's state.
+func (m *Model) pollESMState() tea.Cmd {
+	fn, uuid := m.esmPollFn, m.esmPollUUID
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		mappingState, err := m.client.GetEventSourceMappingState(ctx, uuid)
+		return esmStateMsg{function: fn, uuid: uuid, state: mappingState, err: err}
+	}
+}
+
+// applyESMState updates the cached mapping so its row shows the current
+// (possibly transitional) state.
+func (m *Model) applyESMState(fn, uuid, newState string) {
+	mappings := m.fnMappings[fn]
+	for i := range mappings {
+		if mappings[i].UUID == uuid {
+			mappings[i].State = newState
+			break
+		}
+	}
+	if m.state.View == state.ViewLambda {
+		m.updateLambdaDetails()
+	}
+}
+
+// jumpToTriggeringQueue opens the SQS view focused on the function's
+// triggering queue when it is already loaded.
+func (m *Model) jumpToTriggeringQueue() tea.Cmd {
+	item := m.lambdaList.SelectedItem()
+	if item == nil {
+		return nil
+	}
+	name := m.firstSQSMapping(item.ID)
+	if name == "" {
+		m.logger.Info("No loaded SQS queue triggers %s (load the SQS view first)", item.ID)
+		return nil
+	}
+	return m.openGlobalSearchResult(globalSearchResult{kind: "queue", name: name})
+}
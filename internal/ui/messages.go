@@ -1,6 +1,8 @@
 package ui
 
 import (
+	"time"
+
 	"vaws/internal/aws"
 	"vaws/internal/model"
 )
@@ -25,6 +27,7 @@ type (
 		err       error
 		hasMore   bool // true if more pages are being loaded
 		isAppend  bool // true if this is an incremental update
+		gen       int  // load generation, stale streams are dropped
 	}
 
 	// restAPIsLoadedMsg is sent when REST APIs are loaded.
@@ -39,6 +42,12 @@ type (
 		err  error
 	}
 
+	// apiRoutesLoadedMsg is sent when v2 API routes are loaded.
+	apiRoutesLoadedMsg struct {
+		routes []model.APIRoute
+		err    error
+	}
+
 	// apiStagesLoadedMsg is sent when API stages are loaded.
 	apiStagesLoadedMsg struct {
 		stages []model.APIStage
@@ -97,6 +106,113 @@ type (
 		err       error
 	}
 
+	// ec2ActionMsg is sent when an instance lifecycle action is accepted.
+	ec2ActionMsg struct {
+		instanceID string
+		action     string
+		err        error
+	}
+
+	// ec2StateMsg carries a transitioning instance's polled state.
+	ec2StateMsg struct {
+		instanceID string
+		state      string
+		err        error
+	}
+
+	// stageVarUpdatedMsg is sent when a stage variable update completes.
+	stageVarUpdatedMsg struct {
+		stage string
+		key   string
+		err   error
+	}
+
+	// apiExportedMsg is sent when an API spec export completes.
+	apiExportedMsg struct {
+		path string
+		err  error
+	}
+
+	// tunnelReqMsg carries the response of a request sent through a tunnel.
+	tunnelReqMsg struct {
+		status      string
+		contentType string
+		body        string
+		err         error
+	}
+
+	// itemPutMsg is sent when a put-item completes.
+	itemPutMsg struct {
+		table string
+		err   error
+	}
+
+	// itemDeletedFromTableMsg is sent when a DynamoDB item delete completes.
+	itemDeletedFromTableMsg struct {
+		table string
+		err   error
+	}
+
+	// itemUpdatedMsg is sent when a DynamoDB item update completes.
+	itemUpdatedMsg struct {
+		table string
+		err   error
+	}
+
+	// partiqlResultMsg is sent when a PartiQL execution completes.
+	partiqlResultMsg struct {
+		result *model.QueryResult
+		err    error
+	}
+
+	// taskDefMsg carries a fetched task definition for a service.
+	taskDefMsg struct {
+		service string
+		def     *model.TaskDefinition
+		err     error
+	}
+
+	// taskListMsg carries tasks loaded for the task-level overview.
+	taskListMsg struct {
+		service model.Service
+		tasks   []model.Task
+		err     error
+	}
+
+	// serviceEventsMsg carries a service's fetched event log.
+	serviceEventsMsg struct {
+		service string
+		events  []model.ServiceEvent
+		err     error
+	}
+
+	// execTasksLoadedMsg is sent when tasks are loaded for an ECS Exec shell.
+	execTasksLoadedMsg struct {
+		service model.Service
+		tasks   []model.Task
+		err     error
+	}
+
+	// execShellFinishedMsg is sent when an interactive shell exits.
+	execShellFinishedMsg struct {
+		service string
+		err     error
+	}
+
+	// forceDeployMsg is sent when a forced deployment request completes.
+	forceDeployMsg struct {
+		service model.Service
+		err     error
+	}
+
+	// tunnelTargetCheckedMsg reports the SSM status of an EC2 container
+	// instance a tunnel is about to target.
+	tunnelTargetCheckedMsg struct {
+		instanceID string
+		managed    bool
+		err        error
+	}
+
 	// tunnelRefreshMsg triggers a refresh of the tunnel list.
 	tunnelRefreshMsg struct{}
 
@@ -132,6 +248,7 @@ type (
 		err      error
 		hasMore  bool // true if more items are being loaded
 		isAppend bool // true if this is an incremental update
+		gen      int  // load generation, stale streams are dropped
 	}
 
 	// tablesLoadedMsg is sent when DynamoDB tables are loaded.
@@ -140,6 +257,7 @@ type (
 		err      error
 		hasMore  bool // true if more items are being loaded
 		isAppend bool // true if this is an incremental update
+		gen      int  // load generation, stale streams are dropped
 	}
 
 	// clustersLoadedMsg is sent when ECS clusters are loaded.
@@ -148,6 +266,63 @@ type (
 		err      error
 	}
 
+	// envUpdatedMsg is sent when a Lambda environment update completes.
+	envUpdatedMsg struct {
+		name string
+		env  map[string]string
+		err  error
+	}
+
+	// fnConfigUpdatedMsg is sent when a Lambda config update completes.
+	fnConfigUpdatedMsg struct {
+		name string
+		fn   *model.Function
+		err  error
+	}
+
+	// esmToggledMsg is sent when an event source mapping toggle is accepted.
+	esmToggledMsg struct {
+		function string
+		uuid     string
+		enabled  bool
+		err      error
+	}
+
+	// esmStateMsg carries a settling mapping's polled state.
+	esmStateMsg struct {
+		function string
+		uuid     string
+		state    string
+		err      error
+	}
+
+	// insightsResultMsg carries completed Logs Insights query rows.
+	insightsResultMsg struct {
+		rows []model.InsightsRow
+		err  error
+	}
+
+	// mappingsLoadedMsg carries a function's event source mappings.
+	mappingsLoadedMsg struct {
+		function string
+		mappings []model.EventSourceMapping
+		err      error
+	}
+
+	// aliasesLoadedMsg carries a function's aliases and versions.
+	aliasesLoadedMsg struct {
+		function string
+		aliases  []model.LambdaAlias
+		versions []model.LambdaVersion
+		err      error
+	}
+
+	// asyncInvokedMsg is sent when an async (Event) invocation is accepted.
+	asyncInvokedMsg struct {
+		name string
+		err  error
+	}
+
 	// lambdaInvocationResultMsg is sent when Lambda invocation completes.
 	lambdaInvocationResultMsg struct {
 		result *model.InvocationResult
@@ -166,4 +341,186 @@ type (
 		result *model.QueryResult
 		err    error
 	}
+
+	// sqsSentMsg is sent when an SQS message send completes.
+	sqsSentMsg struct {
+		queueName string
+		messageID string
+		updated   *model.Queue // fresh attributes, nil when the refresh failed
+		err       error
+	}
+
+	// messagesPeekedMsg carries messages peeked from a queue.
+	messagesPeekedMsg struct {
+		queueName string
+		messages  []model.Message
+		err       error
+	}
+
+	// messageDeletedMsg is sent when a targeted message delete completes.
+	messageDeletedMsg struct {
+		messageID string
+		err       error
+	}
+
+	// queuePurgedMsg is sent when a queue purge completes.
+	queuePurgedMsg struct {
+		queueName string
+		err       error
+	}
+
+	// redriveStartedMsg is sent when a DLQ message move task starts.
+	redriveStartedMsg struct {
+		handle    string
+		sourceARN string
+		err       error
+	}
+
+	// redriveCancelledMsg is sent when a move task cancel completes.
+	redriveCancelledMsg struct {
+		moved int64
+		err   error
+	}
+
+	// redriveStatusMsg carries recent message move tasks for a source.
+	redriveStatusMsg struct {
+		tasks []model.MessageMoveTask
+		err   error
+	}
+
+	// stackCountsMsg delivers lazily computed resource counts for one stack.
+	stackCountsMsg struct {
+		stack  string
+		counts aws.StackResourceCounts
+	}
+
+	// ctxSwitchPreviewMsg is sent when the context switcher resolves the
+	// candidate client and account.
+	ctxSwitchPreviewMsg struct {
+		client  *aws.Client
+		account string
+		err     error
+	}
+
+	// ssoLoginFinishedMsg is sent when the aws sso login subprocess exits.
+	ssoLoginFinishedMsg struct {
+		err error
+	}
+
+	// fnTagsMsg streams background tag enrichment for the Lambda list, so
+	// stack grouping works on the flat ListFunctions path (which carries
+	// no tags). Stamped with the load generation to drop stale streams.
+	fnTagsMsg struct {
+		name string
+		tags map[string]string
+		gen  int
+	}
+
+	// tagsLoadedMsg is sent when a resource's tags are fetched for editing.
+	tagsLoadedMsg struct {
+		kind string
+		id   string
+		name string
+		tags map[string]string
+		err  error
+	}
+
+	// fnCodeDownloadedMsg is sent when a deployment package download (or
+	// image URI resolution) completes.
+	fnCodeDownloadedMsg struct {
+		name     string
+		path     string
+		size     int64
+		imageURI string
+		err      error
+	}
+
+	// stackInfoMsg is sent when a stack's full description (outputs and
+	// parameters) loads.
+	stackInfoMsg struct {
+		stack string
+		info  *model.Stack
+		err   error
+	}
+
+	// stackEventsMsg is sent when a stack's event history loads.
+	stackEventsMsg struct {
+		stack  string
+		events []model.StackEvent
+		err    error
+	}
+
+	// stackDriftMsg is sent when a drift detection run completes.
+	stackDriftMsg struct {
+		stack  string
+		status string
+		err    error
+	}
+
+	// stackTreeLoadedMsg is sent when a stack's full resource list loads.
+	stackTreeLoadedMsg struct {
+		stack     string
+		resources []model.StackResource
+		err       error
+	}
+
+	// fnURLLoadedMsg is sent when a function URL config is fetched.
+	// config is nil when the function has no URL.
+	fnURLLoadedMsg struct {
+		name   string
+		config *model.FunctionUrl
+		err    error
+	}
+
+	// tagsSavedMsg is sent when a tag update completes.
+	tagsSavedMsg struct {
+		kind string
+		id   string
+		name string
+		tags map[string]string
+		err  error
+	}
+
+	// ssoClientRebuiltMsg is sent when the client is rebuilt after re-auth.
+	ssoClientRebuiltMsg struct {
+		client *aws.Client
+		err    error
+	}
+
+	// regionLatencyMsg is sent when a region latency probe completes.
+	regionLatencyMsg struct {
+		region  string
+		latency time.Duration
+		err     error
+	}
+
+	// exactCountMsg carries a precise item count for a DynamoDB table.
+	exactCountMsg struct {
+		table   string
+		count   int64
+		elapsed time.Duration
+		err     error
+	}
+
+	// queueMetricsMsg carries depth history for a queue sparkline.
+	queueMetricsMsg struct {
+		queue  string
+		points []model.MetricPoint
+		err    error
+	}
+
+	// alarmsLoadedMsg carries CloudWatch alarms for a resource key.
+	alarmsLoadedMsg struct {
+		key    string // "sqs:<name>" or "lambda:<name>"
+		alarms []model.Alarm
+		err    error
+	}
+
+	// preflightResultMsg is sent when an IAM permission preflight completes.
+	preflightResultMsg struct {
+		action   string
+		resource string
+		allowed  bool
+		ok       bool // false when simulation itself was not possible
+	}
 )
@@ -35,6 +35,9 @@ func (m *Model) moveCursorUp() {
 	case state.ViewServices:
 		m.serviceList.Up()
 		m.updateServiceDetails()
+	case state.ViewTasks:
+		m.tasksList.Up()
+		m.updateTaskDetails()
 	case state.ViewLambda:
 		m.lambdaList.Up()
 		m.updateLambdaDetails()
@@ -44,10 +47,25 @@ func (m *Model) moveCursorUp() {
 	case state.ViewAPIStages:
 		m.apiStagesList.Up()
 		m.updateAPIStageDetails()
+	case state.ViewAPIRoutes:
+		m.apiRoutesList.Up()
 	case state.ViewJumpHostSelect:
 		m.ec2List.Up()
+		m.updateJumpHostDetails()
 	case state.ViewContainerSelect:
 		m.containerList.Up()
+	case state.ViewGlobalSearch:
+		m.globalSearchList.Up()
+	case state.ViewQueryTemplates:
+		m.templatesList.Up()
+	case state.ViewSQSMessages:
+		m.peekList.Up()
+		m.updatePeekedMessageDetails()
+	case state.ViewLambdaAliases:
+		m.aliasList.Up()
+	case state.ViewStackTree:
+		m.stackTreeList.Up()
+		m.updateStackTreeDetails()
 	case state.ViewSQS:
 		m.sqsTable.Up()
 		m.updateQueueDetails()
@@ -74,6 +92,9 @@ func (m *Model) moveCursorDown() {
 	case state.ViewServices:
 		m.serviceList.Down()
 		m.updateServiceDetails()
+	case state.ViewTasks:
+		m.tasksList.Down()
+		m.updateTaskDetails()
 	case state.ViewLambda:
 		m.lambdaList.Down()
 		m.updateLambdaDetails()
@@ -83,10 +104,25 @@ func (m *Model) moveCursorDown() {
 	case state.ViewAPIStages:
 		m.apiStagesList.Down()
 		m.updateAPIStageDetails()
+	case state.ViewAPIRoutes:
+		m.apiRoutesList.Down()
 	case state.ViewJumpHostSelect:
 		m.ec2List.Down()
+		m.updateJumpHostDetails()
 	case state.ViewContainerSelect:
 		m.containerList.Down()
+	case state.ViewGlobalSearch:
+		m.globalSearchList.Down()
+	case state.ViewQueryTemplates:
+		m.templatesList.Down()
+	case state.ViewSQSMessages:
+		m.peekList.Down()
+		m.updatePeekedMessageDetails()
+	case state.ViewLambdaAliases:
+		m.aliasList.Down()
+	case state.ViewStackTree:
+		m.stackTreeList.Down()
+		m.updateStackTreeDetails()
 	case state.ViewSQS:
 		m.sqsTable.Down()
 		m.updateQueueDetails()
@@ -113,6 +149,9 @@ func (m *Model) moveCursorTop() {
 	case state.ViewServices:
 		m.serviceList.Top()
 		m.updateServiceDetails()
+	case state.ViewTasks:
+		m.tasksList.Top()
+		m.updateTaskDetails()
 	case state.ViewLambda:
 		m.lambdaList.Top()
 		m.updateLambdaDetails()
@@ -122,10 +161,25 @@ func (m *Model) moveCursorTop() {
 	case state.ViewAPIStages:
 		m.apiStagesList.Top()
 		m.updateAPIStageDetails()
+	case state.ViewAPIRoutes:
+		m.apiRoutesList.Top()
 	case state.ViewJumpHostSelect:
 		m.ec2List.Top()
+		m.updateJumpHostDetails()
 	case state.ViewContainerSelect:
 		m.containerList.Top()
+	case state.ViewGlobalSearch:
+		m.globalSearchList.Top()
+	case state.ViewQueryTemplates:
+		m.templatesList.Top()
+	case state.ViewSQSMessages:
+		m.peekList.Top()
+		m.updatePeekedMessageDetails()
+	case state.ViewLambdaAliases:
+		m.aliasList.Top()
+	case state.ViewStackTree:
+		m.stackTreeList.Top()
+		m.updateStackTreeDetails()
 	case state.ViewSQS:
 		m.sqsTable.Top()
 		m.updateQueueDetails()
@@ -150,6 +204,9 @@ func (m *Model) moveCursorBottom() {
 	case state.ViewServices:
 		m.serviceList.Bottom()
 		m.updateServiceDetails()
+	case state.ViewTasks:
+		m.tasksList.Bottom()
+		m.updateTaskDetails()
 	case state.ViewLambda:
 		m.lambdaList.Bottom()
 		m.updateLambdaDetails()
@@ -159,10 +216,25 @@ func (m *Model) moveCursorBottom() {
 	case state.ViewAPIStages:
 		m.apiStagesList.Bottom()
 		m.updateAPIStageDetails()
+	case state.ViewAPIRoutes:
+		m.apiRoutesList.Bottom()
 	case state.ViewJumpHostSelect:
 		m.ec2List.Bottom()
+		m.updateJumpHostDetails()
 	case state.ViewContainerSelect:
 		m.containerList.Bottom()
+	case state.ViewGlobalSearch:
+		m.globalSearchList.Bottom()
+	case state.ViewQueryTemplates:
+		m.templatesList.Bottom()
+	case state.ViewSQSMessages:
+		m.peekList.Bottom()
+		m.updatePeekedMessageDetails()
+	case state.ViewLambdaAliases:
+		m.aliasList.Bottom()
+	case state.ViewStackTree:
+		m.stackTreeList.Bottom()
+		m.updateStackTreeDetails()
 	case state.ViewSQS:
 		m.sqsTable.Bottom()
 		m.updateQueueDetails()
@@ -189,60 +261,14 @@ func (m *Model) switchToDynamoDB() tea.Cmd {
 
 // showTunnelsView switches to the tunnels view.
 func (m *Model) showTunnelsView() {
+	// Drop details focus so navigation keys reach the tunnels panel
+	m.details.SetFocused(false)
 	m.state.View = state.ViewTunnels
 	m.updateTunnelsPanel()
 }
 
-// showHelp displays the help information in the logs panel.
+// showHelp opens the full help modal, built from the registered
+// per-view and global keybindings.
 func (m *Model) showHelp() {
-	m.logger.Info("═══════════════════════════════════════════════════════════════")
-	m.logger.Info("                        VAWS HELP")
-	m.logger.Info("═══════════════════════════════════════════════════════════════")
-	m.logger.Info("")
-	m.logger.Info("NAVIGATION:")
-	m.logger.Info("  ↑/k, ↓/j     Navigate up/down")
-	m.logger.Info("  Enter/→      Select item")
-	m.logger.Info("  Esc/←        Go back")
-	m.logger.Info("  g/G          Jump to top/bottom")
-	m.logger.Info("")
-	m.logger.Info("QUICK KEYS:")
-	m.logger.Info("  0            Main menu")
-	m.logger.Info("  1            ECS Clusters")
-	m.logger.Info("  2            Lambda Functions")
-	m.logger.Info("  3            SQS Queues")
-	m.logger.Info("  4            API Gateway")
-	m.logger.Info("  5            CloudFormation Stacks")
-	m.logger.Info("  6            DynamoDB Tables")
-	m.logger.Info("")
-	m.logger.Info("ACTIONS:")
-	m.logger.Info("  :            Open command palette")
-	m.logger.Info("  /            Filter current list")
-	m.logger.Info("  r            Refresh current view")
-	m.logger.Info("  l            Toggle logs panel")
-	m.logger.Info("  L            View CloudWatch logs (on service/Lambda)")
-	m.logger.Info("  i            Invoke Lambda function")
-	m.logger.Info("  p            Port forward (on service)")
-	m.logger.Info("  t            View tunnels")
-	m.logger.Info("  a            Toggle auto-refresh")
-	m.logger.Info("  ?            Show this help")
-	m.logger.Info("  q            Quit")
-	m.logger.Info("")
-	m.logger.Info("COMMANDS (type : then command):")
-	m.logger.Info("  :main        Main menu")
-	m.logger.Info("  :ecs         ECS clusters")
-	m.logger.Info("  :lambda      Lambda functions")
-	m.logger.Info("  :sqs         SQS queues")
-	m.logger.Info("  :apigateway  API Gateway")
-	m.logger.Info("  :stacks      CloudFormation stacks")
-	m.logger.Info("  :dynamodb    DynamoDB tables")
-	m.logger.Info("  :region      Change AWS region")
-	m.logger.Info("  :tunnels     Port forward tunnels")
-	m.logger.Info("  :logs        Toggle logs panel")
-	m.logger.Info("  :refresh     Refresh current view")
-	m.logger.Info("  :quit        Quit application")
-	m.logger.Info("═══════════════════════════════════════════════════════════════")
-
-	// Ensure logs are visible
-	m.state.ShowLogs = true
-	m.updateComponentSizes()
+	m.openHelp()
 }
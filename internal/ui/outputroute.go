@@ -0,0 +1,168 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"vaws/internal/ui/theme"
+)
+
+// outputRoutes are the destinations an invocation result can be sent to.
+var outputRoutes = []string{"file", "clipboard", "editor"}
+
+// editorFinishedMsg is sent when the external editor/pager exits.
+type editorFinishedMsg struct {
+	path string
+	err  error
+}
+
+// openOutputRoute opens the destination chooser for the last invocation
+// result, defaulting to the previously chosen destination.
+func (m *Model) openOutputRoute() tea.Cmd {
+	if m.state.LambdaInvocationResult == nil {
+		m.logger.Warn("No invocation result to route - invoke a function first")
+		return nil
+	}
+
+	m.outputRouteActive = true
+	m.outputRouteCursor = 0
+	for i, route := range outputRoutes {
+		if route == m.lastOutputRoute {
+			m.outputRouteCursor = i
+			break
+		}
+	}
+	return nil
+}
+
+// handleOutputRouteKey handles keys while the destination chooser is open.
+func (m *Model) handleOutputRouteKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		m.outputRouteActive = false
+		return nil
+
+	case "up", "k":
+		if m.outputRouteCursor > 0 {
+			m.outputRouteCursor--
+		}
+		return nil
+
+	case "down", "j":
+		if m.outputRouteCursor < len(outputRoutes)-1 {
+			m.outputRouteCursor++
+		}
+		return nil
+
+	case "enter":
+		m.outputRouteActive = false
+		return m.routeInvocationOutput(outputRoutes[m.outputRouteCursor])
+	}
+
+	return nil
+}
+
+// routeInvocationOutput sends the last invocation result to the chosen
+// destination and remembers the choice for next time.
+func (m *Model) routeInvocationOutput(dest string) tea.Cmd {
+	result := m.state.LambdaInvocationResult
+	if result == nil {
+		return nil
+	}
+	m.lastOutputRoute = dest
+
+	switch dest {
+	case "clipboard":
+		if err := copyToClipboard(result.Payload); err != nil {
+			m.logger.Warn("Clipboard not available: %v", err)
+			return nil
+		}
+		m.logger.Info("Invocation response copied to clipboard")
+		return nil
+
+	case "file":
+		home, err := os.UserHomeDir()
+		if err != nil {
+			m.logger.Error("Failed to resolve home directory: %v", err)
+			return nil
+		}
+		path := filepath.Join(home, ".vaws", "outputs",
+			fmt.Sprintf("%s-%s.json", result.FunctionName, result.InvokedAt.Format("20060102-150405")))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			m.logger.Error("Failed to create output directory: %v", err)
+			return nil
+		}
+		if err := os.WriteFile(path, []byte(result.Payload), 0644); err != nil {
+			m.logger.Error("Failed to write output: %v", err)
+			return nil
+		}
+		m.logger.Info("Invocation response written to %s", path)
+		return nil
+
+	case "editor":
+		// Large outputs are easier in $EDITOR (or a pager) than the scroll view
+		editor := os.Getenv("EDITOR")
+		if editor == "" {
+			editor = os.Getenv("PAGER")
+		}
+		if editor == "" {
+			editor = "less"
+		}
+
+		tmp, err := os.CreateTemp("", "vaws-output-*.json")
+		if err != nil {
+			m.logger.Error("Failed to create temp file: %v", err)
+			return nil
+		}
+		if _, err := tmp.WriteString(result.Payload); err != nil {
+			tmp.Close()
+			m.logger.Error("Failed to write temp file: %v", err)
+			return nil
+		}
+		tmp.Close()
+
+		c := exec.Command(editor, tmp.Name())
+		return tea.ExecProcess(c, func(err error) tea.Msg {
+			return editorFinishedMsg{path: tmp.Name(), err: err}
+		})
+	}
+
+	return nil
+}
+
+// renderOutputRouteDialog renders the destination chooser.
+func (m *Model) renderOutputRouteDialog() string {
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.BorderFocus).
+		Padding(1, 2).
+		Width(40)
+
+	titleStyle := lipgloss.NewStyle().Foreground(theme.Primary).Bold(true)
+	selectedStyle := lipgloss.NewStyle().Foreground(theme.Primary).Bold(true)
+	normalStyle := lipgloss.NewStyle().Foreground(theme.Text)
+	hintStyle := lipgloss.NewStyle().Foreground(theme.TextDim).Italic(true)
+
+	labels := map[string]string{
+		"file":      "File (~/.vaws/outputs/)",
+		"clipboard": "Clipboard",
+		"editor":    "Editor / pager ($EDITOR)",
+	}
+
+	content := titleStyle.Render("Send response to") + "\n\n"
+	for i, route := range outputRoutes {
+		if i == m.outputRouteCursor {
+			content += selectedStyle.Render("▸ "+labels[route]) + "\n"
+		} else {
+			content += normalStyle.Render("  "+labels[route]) + "\n"
+		}
+	}
+	content += "\n" + hintStyle.Render("Enter send • Esc cancel")
+
+	return dialogStyle.Render(content)
+}
@@ -0,0 +1,113 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"vaws/internal/state"
+	"vaws/internal/ui/components"
+)
+
+// startPartiQLEditor opens the statement editor for the selected table.
+func (m *Model) startPartiQLEditor() tea.Cmd {
+	table := m.dynamodbTable.SelectedTable()
+	if table == nil {
+		m.logger.Warn("PartiQL: no table selected")
+		return nil
+	}
+	m.state.SelectTable(table)
+	m.recordRecent("table", table.Name, string(table.Status))
+
+	statement := m.partiqlStatement
+	if statement == "" {
+		statement = fmt.Sprintf("SELECT * FROM %q LIMIT 25", table.Name)
+	}
+
+	m.editorPurpose = editorPurposePartiQL
+	m.structuredEditor.SetFormat(components.FormatText, false)
+	m.structuredEditor.SetSize(m.width, m.height)
+	return m.structuredEditor.Activate("PartiQL: "+table.Name, statement)
+}
+
+// handlePartiQLEditor runs the statement on submit.
+func (m *Model) handlePartiQLEditor(msg tea.Msg) tea.Cmd {
+	result, cmd := m.structuredEditor.Update(msg)
+	if result == nil {
+		return cmd
+	}
+	if result.Cancelled || strings.TrimSpace(result.Value) == "" {
+		return nil
+	}
+
+	statement := result.Value
+	m.partiqlStatement = statement
+	m.logger.Info("Executing PartiQL statement...")
+	return m.executePartiQL(statement, nil)
+}
+
+// executePartiQL runs a statement (or its next page when token is set).
+func (m *Model) executePartiQL(statement string, token *string) tea.Cmd {
+	m.state.DynamoDBQueryLoading = true
+	m.dynamodbQueryResults.SetLoading(true)
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		result, err := m.client.ExecutePartiQL(ctx, statement, token)
+		return partiqlResultMsg{result: result, err: err}
+	}
+}
+
+// handlePartiQLResult renders a completed PartiQL execution through the
+// shared results view, or reopens the editor with the statement preserved
+// when AWS rejected it so the error can be fixed in place.
+func (m *Model) handlePartiQLResult(msg partiqlResultMsg) tea.Cmd {
+	m.state.DynamoDBQueryLoading = false
+	m.dynamodbQueryResults.SetLoading(false)
+
+	if msg.err != nil {
+		m.logger.Error("PartiQL: %v", msg.err)
+		m.state.ShowLogs = true
+		m.updateComponentSizes()
+		// Reopen the editor with the statement so it can be corrected
+		m.editorPurpose = editorPurposePartiQL
+		m.structuredEditor.SetFormat(components.FormatText, false)
+		m.structuredEditor.SetSize(m.width, m.height)
+		return m.structuredEditor.Activate("PartiQL (fix statement)", m.partiqlStatement)
+	}
+
+	tableName, pkName, skName := "", "", ""
+	if m.state.SelectedTable != nil {
+		tableName = m.state.SelectedTable.Name
+		pkName = m.state.SelectedTable.PartitionKey()
+		skName = m.state.SelectedTable.SortKey()
+	}
+
+	// PartiQL results don't know the key schema; fill the display keys in
+	// from the selected table so the results columns populate
+	for i := range msg.result.Items {
+		if v, ok := msg.result.Items[i].Raw[pkName]; ok {
+			msg.result.Items[i].PartitionKeyValue = fmt.Sprintf("%v", v)
+		}
+		if skName != "" {
+			if v, ok := msg.result.Items[i].Raw[skName]; ok {
+				msg.result.Items[i].SortKeyValue = fmt.Sprintf("%v", v)
+			}
+		}
+	}
+
+	// Route through the normal results state so paging and copying work
+	m.state.DynamoDBQueryResult = msg.result
+	m.state.DynamoDBQueryParams = nil
+	m.state.DynamoDBScanParams = nil
+	m.state.DynamoDBQueryError = nil
+	m.state.View = state.ViewDynamoDBQuery
+	m.dynamodbQueryResults.SetResult(msg.result, tableName, pkName, skName)
+	m.logger.Info("PartiQL returned %d item(s) (capacity: %.2f)", msg.result.Count, msg.result.ConsumedCapacity)
+	return nil
+}
@@ -0,0 +1,237 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"vaws/internal/aws"
+	"vaws/internal/state"
+	"vaws/internal/ui/components"
+	"vaws/internal/ui/theme"
+)
+
+// peekBatchSize is how many messages a peek inspects at most.
+const peekBatchSize = 10
+
+// startPeekMessages peeks at the selected queue's messages without
+// consuming them and opens the inspection view.
+func (m *Model) startPeekMessages() tea.Cmd {
+	queue := m.sqsTable.SelectedQueue()
+	if queue == nil {
+		m.logger.Warn("Peek: no queue selected")
+		return nil
+	}
+
+	q := *queue
+	m.state.SelectQueue(queue)
+	m.recordRecent("queue", q.Name, "")
+	m.logger.Info("Peeking at messages in %s (they stay in the queue)...", q.Name)
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		messages, err := m.client.PeekMessages(ctx, q.URL, peekBatchSize)
+		return messagesPeekedMsg{queueName: q.Name, messages: messages, err: err}
+	}
+}
+
+// updatePeekList renders the peeked messages into the list component.
+func (m *Model) updatePeekList() {
+	items := make([]components.ListItem, len(m.state.PeekedMessages))
+	for i, msg := range m.state.PeekedMessages {
+		items[i] = components.ListItem{
+			ID:          msg.MessageID,
+			Title:       truncateString(msg.MessageID, 36),
+			Status:      fmt.Sprintf("rcv %d", msg.ApproximateReceiveCount),
+			StatusStyle: lipgloss.NewStyle().Foreground(theme.Info),
+		}
+	}
+	m.peekList.SetItems(items)
+	m.peekList.SetLoading(false)
+	m.peekList.SetError(nil)
+	m.peekList.SetEmptyMessage("No messages available to peek")
+	m.peekList.Top()
+	m.updatePeekedMessageDetails()
+}
+
+// updatePeekedMessageDetails shows the selected message's body (pretty
+// printed when it parses as JSON) and attributes in the details pane.
+func (m *Model) updatePeekedMessageDetails() {
+	idx := m.peekList.Cursor()
+	if idx < 0 || idx >= len(m.state.PeekedMessages) {
+		m.details.SetTitle("Message")
+		m.details.SetRows(nil)
+		return
+	}
+	msg := m.state.PeekedMessages[idx]
+
+	rows := []components.DetailRow{
+		{Label: "MessageId", Value: msg.MessageID},
+		{Label: "Receives", Value: fmt.Sprintf("%d", msg.ApproximateReceiveCount)},
+	}
+	if v, ok := msg.Attributes["SentTimestamp"]; ok {
+		if millis, err := strconv.ParseInt(v, 10, 64); err == nil {
+			rows = append(rows, components.DetailRow{
+				Label: "Sent",
+				Value: time.UnixMilli(millis).Format("2006-01-02 15:04:05"),
+			})
+		} else {
+			rows = append(rows, components.DetailRow{Label: "Sent", Value: v})
+		}
+	}
+
+	// FIFO system attributes, when present
+	if v, ok := msg.Attributes["MessageGroupId"]; ok {
+		rows = append(rows, components.DetailRow{Label: "Group", Value: v})
+	}
+	if v, ok := msg.Attributes["MessageDeduplicationId"]; ok {
+		rows = append(rows, components.DetailRow{Label: "Dedup", Value: v})
+	}
+	if v, ok := msg.Attributes["SequenceNumber"]; ok {
+		rows = append(rows, components.DetailRow{Label: "Sequence", Value: v})
+	}
+
+	// Custom message attributes, for debugging attribute-based routing
+	if len(msg.MessageAttributes) > 0 {
+		names := make([]string, 0, len(msg.MessageAttributes))
+		for name := range msg.MessageAttributes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		rows = append(rows, components.DetailRow{Label: "", Value: ""}) // Spacer
+		rows = append(rows, components.DetailRow{Label: "Attributes", Value: fmt.Sprintf("%d", len(names))})
+		for _, name := range names {
+			rows = append(rows, components.DetailRow{
+				Label: "  " + truncateString(name, 14),
+				Value: msg.MessageAttributes[name],
+			})
+		}
+	}
+
+	rows = append(rows, components.DetailRow{Label: "", Value: ""}) // Spacer
+	rows = append(rows, components.DetailRow{Label: "Body", Value: ""})
+	for _, line := range strings.Split(msg.PrettyBody(), "\n") {
+		rows = append(rows, components.DetailRow{Label: " ", Value: line})
+	}
+
+	m.details.SetTitle("Message")
+	m.details.SetRows(rows)
+}
+
+// startDeleteMessageConfirm opens the delete confirmation for the selected
+// peeked message.
+func (m *Model) startDeleteMessageConfirm() tea.Cmd {
+	idx := m.peekList.Cursor()
+	if idx < 0 || idx >= len(m.state.PeekedMessages) {
+		return nil
+	}
+	target := m.state.PeekedMessages[idx]
+	m.deleteMsgTarget = &target
+	m.deleteMsgConfirmActive = true
+	return nil
+}
+
+// handleDeleteMsgConfirmKey handles keys while the delete confirmation is open.
+func (m *Model) handleDeleteMsgConfirmKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "enter", "y":
+		target := m.deleteMsgTarget
+		m.deleteMsgConfirmActive = false
+		m.deleteMsgTarget = nil
+		if target == nil || m.state.SelectedQueue == nil {
+			return nil
+		}
+
+		queueURL := m.state.SelectedQueue.URL
+		messageID := target.MessageID
+		m.logger.Info("Deleting message %s...", messageID)
+
+		return func() tea.Msg {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			// Peeked receipt handles are stale by now; re-receive for a
+			// fresh one matched by MessageId
+			err := m.client.DeleteMessageByID(ctx, queueURL, messageID)
+			return messageDeletedMsg{messageID: messageID, err: err}
+		}
+
+	case "esc", "n":
+		m.deleteMsgConfirmActive = false
+		m.deleteMsgTarget = nil
+		return nil
+	}
+	return nil
+}
+
+// renderDeleteMsgConfirmDialog renders the message delete confirmation.
+func (m *Model) renderDeleteMsgConfirmDialog() string {
+	target := m.deleteMsgTarget
+	if target == nil {
+		return ""
+	}
+
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.Error).
+		Padding(1, 2).
+		Width(56)
+
+	titleStyle := lipgloss.NewStyle().Foreground(theme.Error).Bold(true)
+	normalStyle := lipgloss.NewStyle().Foreground(theme.Text)
+	hintStyle := lipgloss.NewStyle().Foreground(theme.TextDim).Italic(true)
+
+	return dialogStyle.Render(
+		titleStyle.Render("⚠ Delete message") + "\n\n" +
+			normalStyle.Render("Delete "+truncateString(target.MessageID, 40)+"?") + "\n" +
+			normalStyle.Render("The message is re-received to get a fresh handle.") + "\n\n" +
+			hintStyle.Render("Enter/y delete • Esc cancel"))
+}
+
+// handleMessageDeleted applies a completed delete.
+func (m *Model) handleMessageDeleted(msg messageDeletedMsg) {
+	if msg.err != nil {
+		if errors.Is(msg.err, aws.ErrMessageNotVisible) {
+			m.logger.Warn("Message %s could not be deleted: %v", msg.messageID, msg.err)
+		} else {
+			m.logger.Error("Failed to delete message %s: %v", msg.messageID, msg.err)
+		}
+		m.state.ShowLogs = true
+		m.updateComponentSizes()
+		return
+	}
+
+	// Drop the deleted message from the peeked list
+	kept := m.state.PeekedMessages[:0]
+	for _, message := range m.state.PeekedMessages {
+		if message.MessageID != msg.messageID {
+			kept = append(kept, message)
+		}
+	}
+	m.state.PeekedMessages = kept
+	m.logger.Info("Message %s deleted", msg.messageID)
+	m.updatePeekList()
+}
+
+// handleMessagesPeeked applies a completed peek.
+func (m *Model) handleMessagesPeeked(msg messagesPeekedMsg) {
+	if msg.err != nil {
+		m.logger.Error("Failed to peek %s: %v", msg.queueName, msg.err)
+		m.state.ShowLogs = true
+		m.updateComponentSizes()
+		return
+	}
+	m.state.PeekedMessages = msg.messages
+	m.state.View = state.ViewSQSMessages
+	m.updatePeekList()
+}
@@ -0,0 +1,124 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"vaws/internal/aws"
+	"vaws/internal/ui/theme"
+)
+
+// startPurgeConfirm opens the purge confirmation for the selected queue.
+func (m *Model) startPurgeConfirm() tea.Cmd {
+	queue := m.sqsTable.SelectedQueue()
+	if queue == nil {
+		m.logger.Warn("Purge: no queue selected")
+		return nil
+	}
+
+	q := *queue
+	m.purgeQueue = &q
+	m.purgeConfirmActive = true
+	m.preflightWarning = ""
+
+	// Optionally preflight the purge permission while the dialog is open
+	if m.cfg != nil && m.cfg.Defaults.PermissionPreflight {
+		return m.preflightAction("sqs:PurgeQueue", q.ARN)
+	}
+	return nil
+}
+
+// handlePurgeConfirmKey handles keys while the purge confirmation is open.
+func (m *Model) handlePurgeConfirmKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "enter", "y":
+		queue := m.purgeQueue
+		m.purgeConfirmActive = false
+		m.purgeQueue = nil
+		m.preflightWarning = ""
+		if queue == nil {
+			return nil
+		}
+
+		q := *queue
+		m.logger.Info("Purging queue %s...", q.Name)
+		return func() tea.Msg {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			err := m.client.PurgeQueue(ctx, q.URL)
+			return queuePurgedMsg{queueName: q.Name, err: err}
+		}
+
+	case "esc", "n":
+		m.purgeConfirmActive = false
+		m.purgeQueue = nil
+		m.preflightWarning = ""
+		m.logger.Info("Purge cancelled")
+		return nil
+	}
+
+	return nil
+}
+
+// renderPurgeConfirmDialog renders the purge confirmation.
+func (m *Model) renderPurgeConfirmDialog() string {
+	queue := m.purgeQueue
+	if queue == nil {
+		return ""
+	}
+
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.Error).
+		Padding(1, 2).
+		Width(52)
+
+	titleStyle := lipgloss.NewStyle().Foreground(theme.Error).Bold(true)
+	normalStyle := lipgloss.NewStyle().Foreground(theme.Text)
+	hintStyle := lipgloss.NewStyle().Foreground(theme.TextDim).Italic(true)
+
+	content := titleStyle.Render("⚠ Purge queue") + "\n\n" +
+		normalStyle.Render(fmt.Sprintf("Delete ALL ~%d message(s) from %s?", queue.ApproximateMessageCount, queue.Name)) + "\n" +
+		normalStyle.Render("This cannot be undone.")
+
+	// Permission preflight warning (when enabled and a denial is predicted)
+	if m.preflightWarning != "" {
+		warnStyle := lipgloss.NewStyle().Foreground(theme.Warning).Bold(true)
+		content += "\n\n" + warnStyle.Render("⚠ "+m.preflightWarning)
+	}
+
+	content += "\n\n" + hintStyle.Render("Enter/y purge • Esc cancel")
+	return dialogStyle.Render(content)
+}
+
+// handleQueuePurged applies the result of a purge.
+func (m *Model) handleQueuePurged(msg queuePurgedMsg) {
+	if msg.err != nil {
+		if errors.Is(msg.err, aws.ErrPurgeInProgress) {
+			m.logger.Warn("Purge of %s already in progress - AWS allows one purge per 60 seconds", msg.queueName)
+		} else {
+			m.logger.Error("Failed to purge %s: %v", msg.queueName, msg.err)
+			m.state.ShowLogs = true
+			m.updateComponentSizes()
+		}
+		return
+	}
+
+	// The purge takes up to 60s server-side; optimistically zero the
+	// displayed counts so the UI reflects the intent immediately
+	for i := range m.state.Queues {
+		if m.state.Queues[i].Name == msg.queueName {
+			m.state.Queues[i].ApproximateMessageCount = 0
+			m.state.Queues[i].ApproximateInFlight = 0
+			break
+		}
+	}
+	m.logger.Info("Queue %s purged", msg.queueName)
+	m.updateQueuesList()
+}
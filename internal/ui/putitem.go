@@ -0,0 +1,152 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"vaws/internal/aws"
+	"vaws/internal/ui/components"
+	"vaws/internal/ui/theme"
+)
+
+// startPutItem opens the new-item editor pre-filled with the table's key
+// schema so the required attributes can't be forgotten. Any JSON object in
+// AttributeValue wire format can be pasted over the template.
+func (m *Model) startPutItem() tea.Cmd {
+	table := m.dynamodbTable.SelectedTable()
+	if table == nil {
+		m.logger.Warn("New item: no table selected")
+		return nil
+	}
+	m.state.SelectTable(table)
+
+	template := fmt.Sprintf("{\n  %q: {\"S\": \"\"}", table.PartitionKey())
+	if sk := table.SortKey(); sk != "" {
+		template += fmt.Sprintf(",\n  %q: {\"S\": \"\"}", sk)
+	}
+	template += "\n}"
+
+	m.editorPurpose = editorPurposePutItem
+	m.structuredEditor.SetFormat(components.FormatJSON, false)
+	m.structuredEditor.SetSize(m.width, m.height)
+	return m.structuredEditor.Activate("New item (AttributeValue JSON): "+table.Name, template)
+}
+
+// handlePutItemEditor validates the new item and moves to the guard prompt.
+func (m *Model) handlePutItemEditor(msg tea.Msg) tea.Cmd {
+	result, cmd := m.structuredEditor.Update(msg)
+	if result == nil {
+		return cmd
+	}
+	if result.Cancelled || strings.TrimSpace(result.Value) == "" {
+		return nil
+	}
+
+	table := m.state.SelectedTable
+	if table == nil {
+		return nil
+	}
+
+	item, err := aws.UnmarshalAttributeValueJSON(result.Value)
+	if err != nil {
+		m.logger.Error("New item: %v", err)
+		return nil
+	}
+	if _, ok := item[table.PartitionKey()]; !ok {
+		m.logger.Error("New item must include the partition key %s", table.PartitionKey())
+		return nil
+	}
+	if sk := table.SortKey(); sk != "" {
+		if _, ok := item[sk]; !ok {
+			m.logger.Error("New item must include the sort key %s", sk)
+			return nil
+		}
+	}
+
+	m.putItemJSON = result.Value
+	m.putItemGuardActive = true
+	return nil
+}
+
+// handlePutItemGuardKey handles the overwrite-guard prompt.
+func (m *Model) handlePutItemGuardKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "y", "n", "enter":
+		guarded := msg.String() == "y"
+		m.putItemGuardActive = false
+
+		table := m.state.SelectedTable
+		itemJSON := m.putItemJSON
+		m.putItemJSON = ""
+		if table == nil || itemJSON == "" {
+			return nil
+		}
+
+		item, err := aws.UnmarshalAttributeValueJSON(itemJSON)
+		if err != nil {
+			m.logger.Error("New item: %v", err)
+			return nil
+		}
+
+		tableName, guardAttr := table.Name, table.PartitionKey()
+		m.logger.Info("Putting item into %s...", tableName)
+		return func() tea.Msg {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			var err error
+			if guarded {
+				err = m.client.PutItemIfNotExists(ctx, tableName, item, guardAttr)
+			} else {
+				err = m.client.PutItem(ctx, tableName, item)
+			}
+			return itemPutMsg{table: tableName, err: err}
+		}
+
+	case "esc":
+		m.putItemGuardActive = false
+		m.putItemJSON = ""
+		return nil
+	}
+	return nil
+}
+
+// renderPutItemGuardDialog renders the overwrite-guard prompt.
+func (m *Model) renderPutItemGuardDialog() string {
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.BorderFocus).
+		Padding(1, 2).
+		Width(52)
+
+	labelStyle := lipgloss.NewStyle().Foreground(theme.Primary).Bold(true)
+	hintStyle := lipgloss.NewStyle().Foreground(theme.TextDim).Italic(true)
+
+	return dialogStyle.Render(
+		labelStyle.Render("Guard against overwrite?") + "\n\n" +
+			"y: only put if no item with this key exists\n" +
+			"n/Enter: put unconditionally (overwrites)\n\n" +
+			hintStyle.Render("Esc cancel"))
+}
+
+// handleItemPut reports the outcome of a put, distinguishing the
+// conditional-check rejection from real failures.
+func (m *Model) handleItemPut(msg itemPutMsg) {
+	if msg.err != nil {
+		if errors.Is(msg.err, aws.ErrItemExists) {
+			m.logger.Warn("Not written: %v", msg.err)
+		} else {
+			m.logger.Error("Failed to put item: %v", msg.err)
+		}
+		m.state.ShowLogs = true
+		m.updateComponentSizes()
+		return
+	}
+	m.logger.Info("Item written to %s", msg.table)
+}
@@ -0,0 +1,50 @@
+package ui
+
+import (
+	"context"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"vaws/internal/model"
+	"vaws/internal/state"
+)
+
+// queueMetricsTTL is how long a fetched depth series stays fresh, so
+// scrolling through queues doesn't hammer CloudWatch.
+const queueMetricsTTL = 60 * time.Second
+
+// queueMetricsEntry caches one queue's depth history.
+type queueMetricsEntry struct {
+	points    []model.MetricPoint
+	fetchedAt time.Time
+}
+
+// fetchQueueMetricsIfStale fetches depth history for the selected queue
+// unless a fresh series is already cached.
+func (m *Model) fetchQueueMetricsIfStale() tea.Cmd {
+	queue := m.sqsTable.SelectedQueue()
+	if queue == nil {
+		return nil
+	}
+	if entry, ok := m.queueMetrics[queue.Name]; ok && time.Since(entry.fetchedAt) < queueMetricsTTL {
+		return nil
+	}
+
+	name := queue.Name
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		points, err := m.client.GetQueueMetrics(ctx, name, time.Hour)
+		return queueMetricsMsg{queue: name, points: points, err: err}
+	}
+}
+
+// afterCursorMove fires any follow-up loads the new selection needs.
+func (m *Model) afterCursorMove() tea.Cmd {
+	if m.state.View == state.ViewSQS {
+		return m.fetchQueueMetricsIfStale()
+	}
+	return nil
+}
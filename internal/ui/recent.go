@@ -0,0 +1,48 @@
+package ui
+
+import (
+	"vaws/internal/state"
+)
+
+// defaultRecentLimit caps the recently-viewed list unless recent_limit
+// overrides it.
+const defaultRecentLimit = 20
+
+// recordRecent notes a resource the user opened, most recent first,
+// deduplicating by kind+name. The list is session-scoped by design -
+// unlike favorites there is nothing to pin or persist.
+func (m *Model) recordRecent(kind, name, extra string) {
+	limit := defaultRecentLimit
+	if m.cfg != nil && m.cfg.Defaults.RecentLimit > 0 {
+		limit = m.cfg.Defaults.RecentLimit
+	}
+
+	out := []globalSearchResult{{kind: kind, name: name, extra: extra}}
+	for _, r := range m.recentResources {
+		if r.kind == kind && r.name == name {
+			continue
+		}
+		out = append(out, r)
+		if len(out) >= limit {
+			break
+		}
+	}
+	m.recentResources = out
+}
+
+// showRecentResources opens the recently-viewed quick-jump list, reusing the
+// unified results view so entries drill into their native views.
+func (m *Model) showRecentResources() {
+	if len(m.recentResources) == 0 {
+		m.logger.Info("No recently viewed resources yet")
+		return
+	}
+
+	m.globalSearchQuery = "recent"
+	m.globalSearchResults = m.recentResources
+	if m.state.View != state.ViewGlobalSearch {
+		m.viewBeforeGlobalSearch = m.state.View
+	}
+	m.state.View = state.ViewGlobalSearch
+	m.updateGlobalSearchList()
+}
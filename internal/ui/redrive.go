@@ -0,0 +1,183 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"vaws/internal/ui/theme"
+)
+
+// startRedrivePreview opens the redrive confirmation dialog for the selected
+// queue's DLQ, showing source, destination, and the approximate message
+// count that will move.
+func (m *Model) startRedrivePreview() tea.Cmd {
+	queue := m.sqsTable.SelectedQueue()
+	if queue == nil {
+		m.logger.Warn("Redrive: no queue selected")
+		return nil
+	}
+	if !queue.HasDLQ || queue.DLQArn == "" {
+		m.logger.Warn("Redrive: queue %s has no DLQ", queue.Name)
+		return nil
+	}
+	if queue.DLQMessageCount == 0 {
+		m.logger.Info("Redrive: DLQ %s is empty - nothing to move", queue.DLQName)
+		return nil
+	}
+
+	m.state.SelectQueue(queue)
+	m.recordRecent("queue", queue.Name, "")
+	m.redriveActive = true
+	m.redriveRateInput.SetValue("")
+	m.redriveRateInput.Focus()
+	m.preflightWarning = ""
+
+	// Optionally preflight the move permission while the dialog is open
+	if m.cfg != nil && m.cfg.Defaults.PermissionPreflight {
+		return tea.Batch(textinput.Blink, m.preflightAction("sqs:StartMessageMoveTask", queue.DLQArn))
+	}
+	return textinput.Blink
+}
+
+// handleRedriveKey handles key messages while the redrive dialog is open.
+func (m *Model) handleRedriveKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "enter":
+		queue := m.state.SelectedQueue
+		rateStr := m.redriveRateInput.Value()
+
+		m.redriveActive = false
+		m.redriveRateInput.Blur()
+		m.preflightWarning = ""
+
+		if queue == nil {
+			return nil
+		}
+
+		var rate int32
+		if rateStr != "" {
+			parsed, err := strconv.Atoi(rateStr)
+			if err != nil || parsed < 1 || parsed > 500 {
+				m.logger.Error("Invalid rate %q (1-500 messages/second, empty for unlimited)", rateStr)
+				return nil
+			}
+			rate = int32(parsed)
+		}
+
+		sourceARN := queue.DLQArn
+		m.logger.Info("Starting redrive: %s -> %s (~%d messages)", queue.DLQName, queue.Name, queue.DLQMessageCount)
+
+		return func() tea.Msg {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			handle, err := m.client.StartMessageMoveTask(ctx, sourceARN, rate)
+			return redriveStartedMsg{handle: handle, sourceARN: sourceARN, err: err}
+		}
+
+	case "esc":
+		m.redriveActive = false
+		m.redriveRateInput.Blur()
+		m.preflightWarning = ""
+		return nil
+	}
+
+	var cmd tea.Cmd
+	m.redriveRateInput, cmd = m.redriveRateInput.Update(msg)
+	return cmd
+}
+
+// checkRedriveStatus fetches recent move tasks for the last redrive source.
+func (m *Model) checkRedriveStatus() tea.Cmd {
+	if m.activeRedriveSource == "" {
+		m.logger.Info("No redrive started this session")
+		return nil
+	}
+	sourceARN := m.activeRedriveSource
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		tasks, err := m.client.ListMessageMoveTasks(ctx, sourceARN)
+		return redriveStatusMsg{tasks: tasks, err: err}
+	}
+}
+
+// redrivePollInterval paces automatic progress checks after a redrive starts.
+const redrivePollInterval = 5 * time.Second
+
+// redrivePollTickMsg schedules the next redrive progress poll.
+type redrivePollTickMsg time.Time
+
+// redrivePollTick schedules the next automatic progress check.
+func (m *Model) redrivePollTick() tea.Cmd {
+	return tea.Tick(redrivePollInterval, func(t time.Time) tea.Msg {
+		return redrivePollTickMsg(t)
+	})
+}
+
+// cancelRedrive cancels the move task started this session, if any.
+func (m *Model) cancelRedrive() tea.Cmd {
+	if m.activeRedriveHandle == "" {
+		m.logger.Info("No redrive in progress to cancel")
+		return nil
+	}
+	handle := m.activeRedriveHandle
+	m.logger.Info("Cancelling move task %s...", handle)
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		moved, err := m.client.CancelMessageMoveTask(ctx, handle)
+		return redriveCancelledMsg{moved: moved, err: err}
+	}
+}
+
+// renderRedriveDialog renders the redrive preview/confirmation dialog.
+func (m *Model) renderRedriveDialog() string {
+	dialogWidth := 60
+	if m.width < 70 {
+		dialogWidth = m.width - 10
+		if dialogWidth < 34 {
+			dialogWidth = 34
+		}
+	}
+
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.BorderFocus).
+		Padding(1, 2).
+		Width(dialogWidth)
+
+	labelStyle := lipgloss.NewStyle().Foreground(theme.Primary).Bold(true)
+	normalStyle := lipgloss.NewStyle().Foreground(theme.Text)
+	warnStyle := lipgloss.NewStyle().Foreground(theme.Warning)
+	hintStyle := lipgloss.NewStyle().Foreground(theme.TextDim).Italic(true)
+
+	queue := m.state.SelectedQueue
+	if queue == nil {
+		return ""
+	}
+
+	content := labelStyle.Render("Redrive DLQ Messages") + "\n\n" +
+		normalStyle.Render("Source:      "+queue.DLQName) + "\n" +
+		normalStyle.Render("Destination: "+queue.Name) + "\n" +
+		warnStyle.Render(fmt.Sprintf("Messages:    ~%d will move", queue.DLQMessageCount)) + "\n\n" +
+		"Max msgs/sec: " + m.redriveRateInput.View()
+
+	// Permission preflight warning (when enabled and a denial is predicted)
+	if m.preflightWarning != "" {
+		preflightStyle := lipgloss.NewStyle().Foreground(theme.Warning).Bold(true)
+		content += "\n\n" + preflightStyle.Render("⚠ "+m.preflightWarning)
+	}
+
+	content += "\n\n" + hintStyle.Render("Enter to start (blank rate = unlimited), Esc to cancel")
+	return dialogStyle.Render(content)
+}
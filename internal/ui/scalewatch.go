@@ -0,0 +1,185 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"vaws/internal/model"
+	"vaws/internal/ui/theme"
+)
+
+const (
+	scaleWatchInterval = 3 * time.Second
+	scaleWatchTimeout  = 3 * time.Minute
+)
+
+// scaleWatchTickMsg schedules the next scaling-progress poll.
+type scaleWatchTickMsg time.Time
+
+// scaleWatchUpdateMsg carries a fresh service description for the overlay.
+type scaleWatchUpdateMsg struct {
+	service *model.Service
+	err     error
+}
+
+// startScaleWatch opens the scaling-progress overlay for the selected
+// service and begins polling DescribeServices until it stabilizes.
+func (m *Model) startScaleWatch() tea.Cmd {
+	item := m.serviceList.SelectedItem()
+	if item == nil {
+		m.logger.Warn("Watch: no service selected")
+		return nil
+	}
+
+	var selected *model.Service
+	for i := range m.state.Services {
+		if m.state.Services[i].Name == item.ID {
+			selected = &m.state.Services[i]
+			break
+		}
+	}
+	if selected == nil || selected.ClusterARN == "" {
+		return nil
+	}
+	return m.startScaleWatchService(*selected)
+}
+
+// startScaleWatchService opens the overlay for a specific service, e.g.
+// right after forcing a new deployment.
+func (m *Model) startScaleWatchService(svc model.Service) tea.Cmd {
+	m.scaleWatchActive = true
+	m.scaleWatchStable = false
+	m.scaleWatchTimedOut = false
+	m.scaleWatchService = &svc
+	m.scaleWatchStarted = time.Now()
+
+	m.logger.Info("Watching %s until it stabilizes...", svc.Name)
+	return m.fetchScaleWatch()
+}
+
+// stopScaleWatch closes the overlay; any in-flight poll result is ignored.
+func (m *Model) stopScaleWatch() {
+	m.scaleWatchActive = false
+	m.scaleWatchService = nil
+}
+
+// scaleWatchTick schedules the next poll.
+func (m *Model) scaleWatchTick() tea.Cmd {
+	return tea.Tick(scaleWatchInterval, func(t time.Time) tea.Msg {
+		return scaleWatchTickMsg(t)
+	})
+}
+
+// fetchScaleWatch re-describes the watched service.
+func (m *Model) fetchScaleWatch() tea.Cmd {
+	svc := m.scaleWatchService
+	if svc == nil {
+		return nil
+	}
+	clusterARN, name := svc.ClusterARN, svc.Name
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		service, err := m.client.DescribeService(ctx, clusterARN, name)
+		return scaleWatchUpdateMsg{service: service, err: err}
+	}
+}
+
+// handleScaleWatchKey handles keys while the overlay is open.
+func (m *Model) handleScaleWatchKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc", "q", "w", "enter":
+		m.stopScaleWatch()
+		return nil
+	case "ctrl+c":
+		m.tunnelManager.StopAllTunnels()
+		return tea.Quit
+	}
+	return nil
+}
+
+// renderScaleWatchDialog renders the scaling-progress overlay.
+func (m *Model) renderScaleWatchDialog() string {
+	svc := m.scaleWatchService
+	if svc == nil {
+		return ""
+	}
+
+	dialogWidth := 60
+	if m.width < 70 {
+		dialogWidth = m.width - 10
+		if dialogWidth < 40 {
+			dialogWidth = 40
+		}
+	}
+
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.BorderFocus).
+		Padding(1, 2).
+		Width(dialogWidth)
+
+	titleStyle := lipgloss.NewStyle().Foreground(theme.Primary).Bold(true)
+	normalStyle := lipgloss.NewStyle().Foreground(theme.Text)
+	dimStyle := lipgloss.NewStyle().Foreground(theme.TextDim)
+	warnStyle := lipgloss.NewStyle().Foreground(theme.Warning)
+	okStyle := lipgloss.NewStyle().Foreground(theme.Success)
+	hintStyle := lipgloss.NewStyle().Foreground(theme.TextDim).Italic(true)
+
+	content := titleStyle.Render("Scaling: "+svc.Name) + "\n\n"
+	content += normalStyle.Render(fmt.Sprintf("Running %d  Pending %d  Desired %d",
+		svc.RunningCount, svc.PendingCount, svc.DesiredCount)) + "\n"
+
+	// Progress bar toward the desired count
+	barWidth := dialogWidth - 10
+	if barWidth < 10 {
+		barWidth = 10
+	}
+	filled := 0
+	if svc.DesiredCount > 0 {
+		filled = svc.RunningCount * barWidth / svc.DesiredCount
+		if filled > barWidth {
+			filled = barWidth
+		}
+	}
+	bar := okStyle.Render(strings.Repeat("█", filled)) + dimStyle.Render(strings.Repeat("░", barWidth-filled))
+	content += bar + "\n\n"
+
+	if d := svc.PrimaryDeployment(); d != nil {
+		rollout := d.RolloutState
+		if rollout == "" {
+			rollout = d.Status
+		}
+		style := normalStyle
+		if rollout == "FAILED" {
+			style = warnStyle
+		}
+		content += dimStyle.Render("Deployment: ") + style.Render(rollout) + "\n"
+	}
+
+	// Placement failures surface inline while waiting
+	if diagnosis := model.DiagnoseServiceEvents(svc.Events); diagnosis != "" {
+		content += warnStyle.Render("⚠ "+diagnosis) + "\n"
+	}
+
+	content += "\n"
+	switch {
+	case m.scaleWatchStable:
+		content += okStyle.Render("✓ Service is stable") + "\n\n" + hintStyle.Render("Esc to close")
+	case m.scaleWatchTimedOut:
+		content += warnStyle.Render("Timed out waiting for stability") + "\n\n" + hintStyle.Render("Esc to close")
+	default:
+		content += dimStyle.Render(fmt.Sprintf("Polling every %s (%s elapsed)",
+			scaleWatchInterval, time.Since(m.scaleWatchStarted).Round(time.Second))) + "\n\n" +
+			hintStyle.Render("Esc to stop watching")
+	}
+
+	return dialogStyle.Render(content)
+}
@@ -0,0 +1,116 @@
+package ui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"vaws/internal/model"
+	"vaws/internal/state"
+	"vaws/internal/ui/theme"
+)
+
+// defaultScanWarnThresholdBytes guards scans on tables above 1 GB unless
+// scan_warn_threshold_mb overrides it.
+const defaultScanWarnThresholdBytes = int64(1024) * 1024 * 1024
+
+// scanWarnThresholdBytes returns the configured scan warning threshold.
+func (m *Model) scanWarnThresholdBytes() int64 {
+	if m.cfg != nil && m.cfg.Defaults.ScanWarnThresholdMB > 0 {
+		return int64(m.cfg.Defaults.ScanWarnThresholdMB) * 1024 * 1024
+	}
+	return defaultScanWarnThresholdBytes
+}
+
+// confirmOrStartScan runs a scan immediately for small or limited tables and
+// otherwise shows a cost-estimate confirmation first, using the size already
+// known from DescribeTable.
+func (m *Model) confirmOrStartScan(params *model.ScanParams) tea.Cmd {
+	table := m.state.SelectedTable
+	if table == nil || table.SizeBytes < m.scanWarnThresholdBytes() || params.Limit > 0 {
+		return m.startScan(params)
+	}
+
+	m.scanConfirmActive = true
+	m.pendingScanParams = params
+	return nil
+}
+
+// startScan sets up state and executes a scan through the normal path.
+func (m *Model) startScan(params *model.ScanParams) tea.Cmd {
+	m.state.DynamoDBQueryParams = nil
+	m.state.DynamoDBScanParams = params
+	m.state.DynamoDBIsQuery = false
+	m.state.DynamoDBQueryLoading = true
+	m.state.DynamoDBLastKey = nil
+	m.state.View = state.ViewDynamoDBQuery
+	m.dynamodbQueryResults.SetLoading(true)
+	m.dynamodbQueryResults.Clear()
+	m.logger.Info("Executing scan on table: %s", params.TableName)
+	return m.executeDynamoDBScan(params)
+}
+
+// handleScanConfirmKey handles keys while the scan cost warning is open.
+func (m *Model) handleScanConfirmKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "enter", "y":
+		params := m.pendingScanParams
+		m.scanConfirmActive = false
+		m.pendingScanParams = nil
+		if params == nil {
+			return nil
+		}
+		return m.startScan(params)
+
+	case "l":
+		// Proceed with a bounded scan instead of a full one
+		params := m.pendingScanParams
+		m.scanConfirmActive = false
+		m.pendingScanParams = nil
+		if params == nil {
+			return nil
+		}
+		params.Limit = 1000
+		m.logger.Info("Scanning with a 1000-item limit")
+		return m.startScan(params)
+
+	case "esc", "n":
+		m.scanConfirmActive = false
+		m.pendingScanParams = nil
+		m.logger.Info("Scan cancelled")
+		return nil
+	}
+
+	return nil
+}
+
+// renderScanConfirmDialog renders the scan cost warning.
+func (m *Model) renderScanConfirmDialog() string {
+	table := m.state.SelectedTable
+	if table == nil {
+		return ""
+	}
+
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.Warning).
+		Padding(1, 2).
+		Width(56)
+
+	titleStyle := lipgloss.NewStyle().Foreground(theme.Warning).Bold(true)
+	normalStyle := lipgloss.NewStyle().Foreground(theme.Text)
+	hintStyle := lipgloss.NewStyle().Foreground(theme.TextDim).Italic(true)
+
+	// Eventually-consistent reads cost 0.5 RCU per 4KB read
+	estimatedRCU := table.SizeBytes / 4096 / 2
+
+	content := titleStyle.Render("⚠ Large table scan") + "\n\n" +
+		normalStyle.Render(fmt.Sprintf("Table: %s", table.Name)) + "\n" +
+		normalStyle.Render(fmt.Sprintf("Size:  %s (~%d items)", formatBytes(table.SizeBytes), table.ItemCount)) + "\n" +
+		normalStyle.Render(fmt.Sprintf("A full scan will consume roughly %d RCUs", estimatedRCU)) + "\n\n" +
+		normalStyle.Render("Consider a query on a key instead of a scan.") + "\n\n" +
+		hintStyle.Render("Enter/y scan anyway • l scan with 1000-item limit • Esc cancel")
+
+	return dialogStyle.Render(content)
+}
@@ -0,0 +1,256 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"vaws/internal/state"
+	"vaws/internal/ui/components"
+	"vaws/internal/ui/theme"
+)
+
+// globalSearchResult ties a matched resource to the view it lives in.
+type globalSearchResult struct {
+	kind  string // "stack", "cluster", "service", "function", "queue", "table", "api"
+	name  string
+	extra string
+}
+
+// startGlobalSearch opens the global search input dialog.
+func (m *Model) startGlobalSearch() tea.Cmd {
+	m.globalSearching = true
+	m.globalSearchInput.SetValue("")
+	m.globalSearchInput.Focus()
+	return textinput.Blink
+}
+
+// handleGlobalSearchKey handles key messages while the search dialog is open.
+func (m *Model) handleGlobalSearchKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "enter":
+		query := strings.TrimSpace(m.globalSearchInput.Value())
+		m.globalSearching = false
+		m.globalSearchInput.Blur()
+		if query == "" {
+			return nil
+		}
+		m.runGlobalSearch(query)
+		return nil
+
+	case "esc":
+		m.globalSearching = false
+		m.globalSearchInput.Blur()
+		return nil
+	}
+
+	var cmd tea.Cmd
+	m.globalSearchInput, cmd = m.globalSearchInput.Update(msg)
+	return cmd
+}
+
+// runGlobalSearch searches every already-loaded resource list and switches to
+// the unified results view. Types that haven't been loaded yet are reported
+// so the user knows how to widen the search.
+func (m *Model) runGlobalSearch(query string) {
+	m.globalSearchQuery = query
+	m.globalSearchResults = m.performGlobalSearch(query)
+
+	var unloaded []string
+	if m.state.Stacks == nil {
+		unloaded = append(unloaded, "stacks")
+	}
+	if m.state.Clusters == nil {
+		unloaded = append(unloaded, "clusters")
+	}
+	if m.state.Functions == nil {
+		unloaded = append(unloaded, "lambda")
+	}
+	if m.state.Queues == nil {
+		unloaded = append(unloaded, "sqs")
+	}
+	if m.state.Tables == nil {
+		unloaded = append(unloaded, "dynamodb")
+	}
+	if m.state.RestAPIs == nil && m.state.HttpAPIs == nil {
+		unloaded = append(unloaded, "apigateway")
+	}
+	if len(unloaded) > 0 {
+		m.logger.Info("Search skipped unloaded types: %s (visit those views to include them)", strings.Join(unloaded, ", "))
+	}
+
+	m.logger.Info("Search %q matched %d resources", query, len(m.globalSearchResults))
+
+	if m.state.View != state.ViewGlobalSearch {
+		m.viewBeforeGlobalSearch = m.state.View
+	}
+	m.state.View = state.ViewGlobalSearch
+	m.updateGlobalSearchList()
+}
+
+// performGlobalSearch matches query against every loaded list, ranking
+// exact matches before prefix matches before substring matches before fuzzy
+// (in-order subsequence) matches, then grouping the results by type.
+func (m *Model) performGlobalSearch(query string) []globalSearchResult {
+	q := strings.ToLower(query)
+	var exact, prefix, contains, fuzzy []globalSearchResult
+
+	add := func(kind, name, extra string) {
+		ln := strings.ToLower(name)
+		res := globalSearchResult{kind: kind, name: name, extra: extra}
+		switch {
+		case ln == q:
+			exact = append(exact, res)
+		case strings.HasPrefix(ln, q):
+			prefix = append(prefix, res)
+		case strings.Contains(ln, q):
+			contains = append(contains, res)
+		case fuzzyMatch(ln, q):
+			fuzzy = append(fuzzy, res)
+		}
+	}
+
+	for _, s := range m.state.Stacks {
+		add("stack", s.Name, string(s.Status))
+	}
+	for _, c := range m.state.Clusters {
+		add("cluster", c.Name, fmt.Sprintf("%d services", c.ActiveServicesCount))
+	}
+	for _, s := range m.state.Services {
+		add("service", s.Name, s.ClusterName)
+	}
+	for _, f := range m.state.Functions {
+		add("function", f.Name, f.Runtime)
+	}
+	for _, queue := range m.state.Queues {
+		add("queue", queue.Name, fmt.Sprintf("%d msgs", queue.ApproximateMessageCount))
+	}
+	for _, t := range m.state.Tables {
+		add("table", t.Name, string(t.Status))
+	}
+	for _, a := range m.state.RestAPIs {
+		add("api", a.Name, "REST")
+	}
+	for _, a := range m.state.HttpAPIs {
+		add("api", a.Name, a.ProtocolType)
+	}
+
+	results := append(exact, prefix...)
+	results = append(results, contains...)
+	results = append(results, fuzzy...)
+	return groupByKind(results)
+}
+
+// fuzzyMatch reports whether all runes of q appear in s in order, so
+// "usrtbl" still finds "users-table" without being an exact substring.
+func fuzzyMatch(s, q string) bool {
+	qr := []rune(q)
+	i := 0
+	for _, r := range s {
+		if i < len(qr) && r == qr[i] {
+			i++
+		}
+	}
+	return len(qr) > 0 && i == len(qr)
+}
+
+// groupByKind orders ranked results into fixed type groups so all stacks
+// list together, then clusters, and so on; rank is preserved within each
+// group.
+func groupByKind(results []globalSearchResult) []globalSearchResult {
+	order := []string{"stack", "cluster", "service", "function", "queue", "table", "api"}
+	grouped := make([]globalSearchResult, 0, len(results))
+	for _, kind := range order {
+		for _, res := range results {
+			if res.kind == kind {
+				grouped = append(grouped, res)
+			}
+		}
+	}
+	return grouped
+}
+
+// updateGlobalSearchList renders the unified results into the list component.
+func (m *Model) updateGlobalSearchList() {
+	kindStyle := lipgloss.NewStyle().Foreground(theme.Info)
+
+	items := make([]components.ListItem, len(m.globalSearchResults))
+	for i, res := range m.globalSearchResults {
+		items[i] = components.ListItem{
+			ID:          fmt.Sprintf("result:%d", i),
+			Title:       res.name,
+			Status:      res.kind,
+			StatusStyle: kindStyle,
+			Extra:       res.extra,
+		}
+	}
+	m.globalSearchList.SetItems(items)
+	m.globalSearchList.SetLoading(false)
+	m.globalSearchList.SetError(nil)
+	m.globalSearchList.SetEmptyMessage(fmt.Sprintf("No loaded resources match %q", m.globalSearchQuery))
+	m.globalSearchList.Top()
+}
+
+// openGlobalSearchResult drills into the matched resource's native view,
+// pre-filtered to the matched name.
+func (m *Model) openGlobalSearchResult(res globalSearchResult) tea.Cmd {
+	var cmd tea.Cmd
+	switch res.kind {
+	case "stack":
+		cmd = m.switchToStacks()
+	case "cluster":
+		cmd = m.switchToECS()
+	case "service":
+		m.state.View = state.ViewServices
+	case "function":
+		cmd = m.switchToLambda()
+	case "queue":
+		cmd = m.switchToSQS()
+	case "table":
+		cmd = m.switchToDynamoDB()
+	case "api":
+		cmd = m.switchToAPIGateway()
+	default:
+		return nil
+	}
+
+	// Pre-filter the target view to the matched resource
+	m.state.FilterText = res.name
+	m.filterInput.SetValue(res.name)
+	m.updateCurrentList()
+	return cmd
+}
+
+// renderGlobalSearchDialog renders the global search input dialog.
+func (m *Model) renderGlobalSearchDialog() string {
+	dialogWidth := 60
+	if m.width < 70 {
+		dialogWidth = m.width - 10
+		if dialogWidth < 30 {
+			dialogWidth = 30
+		}
+	}
+
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.BorderFocus).
+		Padding(1, 2).
+		Width(dialogWidth)
+
+	labelStyle := lipgloss.NewStyle().
+		Foreground(theme.Primary).
+		Bold(true)
+
+	hintStyle := lipgloss.NewStyle().
+		Foreground(theme.TextDim).
+		Italic(true)
+
+	dialogContent := labelStyle.Render("Search all resources") + "\n\n" +
+		m.globalSearchInput.View() + "\n\n" +
+		hintStyle.Render("Fuzzy-searches loaded stacks, services, functions, queues, tables, APIs")
+
+	return dialogStyle.Render(dialogContent)
+}
@@ -0,0 +1,168 @@
+package ui
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"vaws/internal/model"
+	"vaws/internal/ui/components"
+	"vaws/internal/ui/theme"
+)
+
+// FIFO prompt stages after the body editor closes.
+const (
+	sendMsgStageNone = iota
+	sendMsgStageGroupID
+	sendMsgStageDedupID
+)
+
+// startSendMessage opens the body editor for the selected queue.
+func (m *Model) startSendMessage() tea.Cmd {
+	queue := m.sqsTable.SelectedQueue()
+	if queue == nil {
+		m.logger.Warn("Send: no queue selected")
+		return nil
+	}
+
+	q := *queue
+	m.sendMsgQueue = &q
+	m.recordRecent("queue", q.Name, "")
+	m.sendMsgStage = sendMsgStageNone
+	m.sendMsgGroupID = ""
+
+	m.editorPurpose = editorPurposeSQSSend
+	m.structuredEditor.SetFormat(components.FormatText, false)
+	m.structuredEditor.SetSize(m.width, m.height)
+	return m.structuredEditor.Activate("Send message to "+q.Name, "")
+}
+
+// handleSendMessageEditor routes keys to the body editor and advances to the
+// FIFO prompts (or the send itself) when the body is submitted.
+func (m *Model) handleSendMessageEditor(msg tea.Msg) tea.Cmd {
+	result, cmd := m.structuredEditor.Update(msg)
+	if result == nil {
+		return cmd
+	}
+	if result.Cancelled {
+		m.sendMsgQueue = nil
+		return nil
+	}
+	if strings.TrimSpace(result.Value) == "" {
+		m.logger.Warn("Send cancelled: message body cannot be empty")
+		m.sendMsgQueue = nil
+		return nil
+	}
+
+	m.sendMsgBody = result.Value
+	if m.sendMsgQueue != nil && m.sendMsgQueue.Type == model.QueueTypeFIFO {
+		// FIFO sends are rejected without a MessageGroupId
+		m.sendMsgStage = sendMsgStageGroupID
+		m.sendMsgInput.SetValue("")
+		m.sendMsgInput.Placeholder = "MessageGroupId (required)"
+		m.sendMsgInput.Focus()
+		return textinput.Blink
+	}
+	return m.sendQueueMessage("", "")
+}
+
+// handleSendMsgPromptKey handles the FIFO group/dedup id prompts.
+func (m *Model) handleSendMsgPromptKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "enter":
+		value := strings.TrimSpace(m.sendMsgInput.Value())
+		switch m.sendMsgStage {
+		case sendMsgStageGroupID:
+			if value == "" {
+				return nil // required for FIFO queues
+			}
+			m.sendMsgGroupID = value
+			m.sendMsgStage = sendMsgStageDedupID
+			m.sendMsgInput.SetValue("")
+			m.sendMsgInput.Placeholder = "MessageDeduplicationId (optional)"
+			return textinput.Blink
+
+		case sendMsgStageDedupID:
+			m.sendMsgStage = sendMsgStageNone
+			m.sendMsgInput.Blur()
+			return m.sendQueueMessage(m.sendMsgGroupID, value)
+		}
+		return nil
+
+	case "esc":
+		m.sendMsgStage = sendMsgStageNone
+		m.sendMsgInput.Blur()
+		m.sendMsgQueue = nil
+		return nil
+	}
+
+	var cmd tea.Cmd
+	m.sendMsgInput, cmd = m.sendMsgInput.Update(msg)
+	return cmd
+}
+
+// sendQueueMessage performs the send and refreshes the queue's counts.
+func (m *Model) sendQueueMessage(groupID, dedupID string) tea.Cmd {
+	queue := m.sendMsgQueue
+	m.sendMsgQueue = nil
+	if queue == nil {
+		return nil
+	}
+
+	body := m.sendMsgBody
+	q := *queue
+	m.logger.Info("Sending message to %s...", q.Name)
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		var messageID string
+		var err error
+		if q.Type == model.QueueTypeFIFO {
+			messageID, err = m.client.SendFIFOMessage(ctx, q.URL, body, nil, groupID, dedupID)
+		} else {
+			messageID, err = m.client.SendMessage(ctx, q.URL, body, nil)
+		}
+		if err != nil {
+			return sqsSentMsg{queueName: q.Name, err: err}
+		}
+
+		// Refresh the approximate counts right away
+		updated, _ := m.client.GetQueueAttributes(ctx, q.URL)
+		return sqsSentMsg{queueName: q.Name, messageID: messageID, updated: updated}
+	}
+}
+
+// renderSendMsgPromptDialog renders the FIFO group/dedup id prompt.
+func (m *Model) renderSendMsgPromptDialog() string {
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.BorderFocus).
+		Padding(1, 2).
+		Width(50)
+
+	labelStyle := lipgloss.NewStyle().Foreground(theme.Primary).Bold(true)
+	hintStyle := lipgloss.NewStyle().Foreground(theme.TextDim).Italic(true)
+
+	queueName := ""
+	if m.sendMsgQueue != nil {
+		queueName = m.sendMsgQueue.Name
+	}
+
+	label := "MessageGroupId"
+	hint := "Required for FIFO queues"
+	if m.sendMsgStage == sendMsgStageDedupID {
+		label = "MessageDeduplicationId"
+		hint = "Optional - Enter to skip"
+	}
+
+	return dialogStyle.Render(
+		labelStyle.Render("FIFO send: "+queueName) + "\n\n" +
+			label + ": " + m.sendMsgInput.View() + "\n\n" +
+			hintStyle.Render(hint+" • Esc cancel"))
+}
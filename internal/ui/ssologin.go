@@ -0,0 +1,95 @@
+package ui
+
+import (
+	"context"
+	"os/exec"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"vaws/internal/aws"
+	"vaws/internal/ui/theme"
+)
+
+// maybeOfferSSOLogin opens the guided re-auth modal when err is an expired
+// SSO token, so loads fail with a way forward instead of a raw credentials
+// error. Returns true when the modal was opened.
+func (m *Model) maybeOfferSSOLogin(err error) bool {
+	if err == nil || !aws.IsSSOTokenExpired(err) || m.ssoLoginActive {
+		return false
+	}
+	if m.dialogOpen() {
+		// Don't steal keys from whatever flow is open; leave a pointer
+		m.logger.Warn("SSO session expired - run: aws sso login --profile %s", m.state.Profile)
+		return false
+	}
+	m.ssoLoginActive = true
+	m.logger.Warn("SSO session expired for profile %s", m.state.Profile)
+	return true
+}
+
+// handleSSOLoginKey handles keys while the re-auth modal is open.
+func (m *Model) handleSSOLoginKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "enter":
+		m.ssoLoginActive = false
+		m.logger.Info("Running: aws sso login --profile %s", m.state.Profile)
+		c := exec.Command("aws", "sso", "login", "--profile", m.state.Profile)
+		return tea.ExecProcess(c, func(err error) tea.Msg {
+			return ssoLoginFinishedMsg{err: err}
+		})
+
+	case "esc":
+		m.ssoLoginActive = false
+	}
+	return nil
+}
+
+// handleSSOLoginFinished rebuilds the client after a successful login and
+// retries the load that failed by refreshing the current view.
+func (m *Model) handleSSOLoginFinished(msg ssoLoginFinishedMsg) tea.Cmd {
+	if msg.err != nil {
+		m.logger.Error("aws sso login failed: %v", msg.err)
+		return nil
+	}
+
+	m.logger.Info("SSO login succeeded - rebuilding credentials...")
+	profile, region := m.state.Profile, m.state.Region
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		client, err := aws.NewClient(ctx, profile, region)
+		return ssoClientRebuiltMsg{client: client, err: err}
+	}
+}
+
+// renderSSOLoginDialog renders the expired-session re-auth modal.
+func (m *Model) renderSSOLoginDialog() string {
+	dialogWidth := 56
+	if m.width < 66 {
+		dialogWidth = m.width - 10
+		if dialogWidth < 30 {
+			dialogWidth = 30
+		}
+	}
+
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.BorderFocus).
+		Padding(1, 2).
+		Width(dialogWidth)
+
+	titleStyle := lipgloss.NewStyle().Foreground(theme.Warning).Bold(true)
+	normalStyle := lipgloss.NewStyle().Foreground(theme.Text)
+	hintStyle := lipgloss.NewStyle().Foreground(theme.TextDim).Italic(true)
+
+	content := titleStyle.Render("SSO session expired") + "\n\n" +
+		normalStyle.Render("The SSO session for profile '"+m.state.Profile+"' has expired.") + "\n" +
+		normalStyle.Render("vaws can run the login for you and retry the load:") + "\n\n" +
+		normalStyle.Render("  aws sso login --profile "+m.state.Profile) + "\n\n" +
+		hintStyle.Render("Enter run login • Esc dismiss")
+
+	return dialogStyle.Render(content)
+}
@@ -0,0 +1,123 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"vaws/internal/model"
+	"vaws/internal/state"
+	"vaws/internal/ui/components"
+	"vaws/internal/ui/theme"
+)
+
+// stackEventsLimit is how many events the panel fetches.
+const stackEventsLimit = 25
+
+// loadStackEvents fetches the freshest events for the selected stack so a
+// stuck UPDATE_IN_PROGRESS can be diagnosed in the details pane.
+func (m *Model) loadStackEvents() tea.Cmd {
+	item := m.stacksList.SelectedItem()
+	if item == nil {
+		return nil
+	}
+	stackName := item.ID
+
+	m.logger.Info("Loading events for stack %s...", stackName)
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		events, err := m.client.GetStackEvents(ctx, stackName, stackEventsLimit)
+		return stackEventsMsg{stack: stackName, events: events, err: err}
+	}
+}
+
+// detectStackDrift runs drift detection for the selected stack. This can
+// take a while on large stacks; the result lands in the details pane.
+func (m *Model) detectStackDrift() tea.Cmd {
+	item := m.stacksList.SelectedItem()
+	if item == nil {
+		return nil
+	}
+	stackName := item.ID
+
+	m.logger.Info("Detecting drift for stack %s (this can take a while)...", stackName)
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
+		defer cancel()
+
+		status, err := m.client.DetectStackDrift(ctx, stackName)
+		return stackDriftMsg{stack: stackName, status: status, err: err}
+	}
+}
+
+// stackEventDetailRows renders fetched events for a stack, newest first.
+// Rows share the resource-status coloring of the stack tree.
+func (m *Model) stackEventDetailRows(stackName string) []components.DetailRow {
+	rows := []components.DetailRow{}
+
+	if drift, ok := m.stackDrift[stackName]; ok {
+		style := lipgloss.NewStyle().Foreground(theme.Success)
+		if drift != "IN_SYNC" {
+			style = lipgloss.NewStyle().Foreground(theme.Error)
+		}
+		rows = append(rows, components.DetailRow{Label: "", Value: ""}) // Spacer
+		rows = append(rows, components.DetailRow{Label: "Drift", Value: drift, Style: style})
+	}
+
+	events, ok := m.stackEvents[stackName]
+	if !ok {
+		return rows
+	}
+
+	rows = append(rows, components.DetailRow{Label: "", Value: ""}) // Spacer
+	rows = append(rows, components.DetailRow{Label: "Events", Value: fmt.Sprintf("%d (newest first)", len(events))})
+	for _, e := range events {
+		value := fmt.Sprintf("%s %s", e.LogicalID, e.Status)
+		if e.Reason != "" {
+			value += " - " + e.Reason
+		}
+		rows = append(rows, components.DetailRow{
+			Label: "  " + e.Timestamp.Format("15:04:05"),
+			Value: value,
+			Style: stackTreeStatusStyle(e.Status),
+		})
+	}
+	return rows
+}
+
+// handleStackEvents applies fetched stack events.
+func (m *Model) handleStackEvents(msg stackEventsMsg) {
+	if msg.err != nil {
+		m.logger.Error("Failed to load events for %s: %v", msg.stack, msg.err)
+		return
+	}
+	if m.stackEvents == nil {
+		m.stackEvents = make(map[string][]model.StackEvent)
+	}
+	m.stackEvents[msg.stack] = msg.events
+	m.logger.Info("Loaded %d event(s) for %s", len(msg.events), msg.stack)
+	if m.state.View == state.ViewStacks {
+		m.updateStackDetails()
+	}
+}
+
+// handleStackDrift applies a completed drift detection.
+func (m *Model) handleStackDrift(msg stackDriftMsg) {
+	if msg.err != nil {
+		m.logger.Error("Drift detection for %s failed: %v", msg.stack, msg.err)
+		return
+	}
+	if m.stackDrift == nil {
+		m.stackDrift = make(map[string]string)
+	}
+	m.stackDrift[msg.stack] = msg.status
+	m.logger.Info("Drift status for %s: %s", msg.stack, msg.status)
+	if m.state.View == state.ViewStacks {
+		m.updateStackDetails()
+	}
+}
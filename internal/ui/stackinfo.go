@@ -0,0 +1,112 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"vaws/internal/model"
+	"vaws/internal/state"
+	"vaws/internal/ui/components"
+)
+
+// loadStackInfo fetches the selected stack's full description - outputs and
+// parameters - for the details pane, saving the console round-trip for an
+// API URL.
+func (m *Model) loadStackInfo() tea.Cmd {
+	item := m.stacksList.SelectedItem()
+	if item == nil {
+		return nil
+	}
+	stackName := item.ID
+
+	m.logger.Info("Loading outputs and parameters for %s...", stackName)
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		info, err := m.client.DescribeStack(ctx, stackName)
+		return stackInfoMsg{stack: stackName, info: info, err: err}
+	}
+}
+
+// handleStackInfo caches a fetched stack description.
+func (m *Model) handleStackInfo(msg stackInfoMsg) {
+	if msg.err != nil {
+		m.logger.Error("Failed to describe %s: %v", msg.stack, msg.err)
+		return
+	}
+	if m.stackInfo == nil {
+		m.stackInfo = make(map[string]*model.Stack)
+	}
+	m.stackInfo[msg.stack] = msg.info
+	m.logger.Info("Loaded %d output(s) and %d parameter(s) for %s (Y copies outputs)",
+		len(msg.info.Outputs), len(msg.info.Parameters), msg.stack)
+	if m.state.View == state.ViewStacks {
+		m.updateStackDetails()
+	}
+}
+
+// stackInfoDetailRows renders cached outputs and parameters, masking NoEcho
+// parameter values.
+func (m *Model) stackInfoDetailRows(stackName string) []components.DetailRow {
+	info, ok := m.stackInfo[stackName]
+	if !ok {
+		return nil
+	}
+
+	var rows []components.DetailRow
+	if len(info.Outputs) > 0 {
+		rows = append(rows, components.DetailRow{Label: "", Value: ""}) // Spacer
+		rows = append(rows, components.DetailRow{Label: "Outputs", Value: fmt.Sprintf("%d", len(info.Outputs))})
+		for _, out := range info.Outputs {
+			rows = append(rows, components.DetailRow{
+				Label: "  " + truncateString(out.Key, 14),
+				Value: out.Value,
+			})
+		}
+	}
+
+	if len(info.Parameters) > 0 {
+		rows = append(rows, components.DetailRow{Label: "", Value: ""}) // Spacer
+		rows = append(rows, components.DetailRow{Label: "Parameters", Value: fmt.Sprintf("%d", len(info.Parameters))})
+		for _, p := range info.Parameters {
+			value := p.Value
+			if p.NoEcho {
+				value = "••••••••"
+			}
+			rows = append(rows, components.DetailRow{
+				Label: "  " + truncateString(p.Key, 14),
+				Value: value,
+			})
+		}
+	}
+	return rows
+}
+
+// copyStackOutputs copies the selected stack's outputs as key=value lines.
+func (m *Model) copyStackOutputs() {
+	item := m.stacksList.SelectedItem()
+	if item == nil {
+		return
+	}
+
+	info, ok := m.stackInfo[item.ID]
+	if !ok || len(info.Outputs) == 0 {
+		m.logger.Warn("No outputs loaded for %s (press O first)", item.ID)
+		return
+	}
+
+	var b strings.Builder
+	for _, out := range info.Outputs {
+		b.WriteString(fmt.Sprintf("%s=%s\n", out.Key, out.Value))
+	}
+	if err := copyToClipboard(b.String()); err != nil {
+		m.logger.Warn("Clipboard not available:\n%s", b.String())
+		return
+	}
+	m.logger.Info("Copied %d output(s) to clipboard", len(info.Outputs))
+}
@@ -0,0 +1,179 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"vaws/internal/model"
+	"vaws/internal/state"
+	"vaws/internal/ui/components"
+	"vaws/internal/ui/theme"
+)
+
+// openStackTree loads the full resource listing for the selected stack and
+// switches to the tree view.
+func (m *Model) openStackTree() tea.Cmd {
+	if m.state.SelectedStack == nil {
+		return nil
+	}
+	stackName := m.state.SelectedStack.Name
+
+	m.state.View = state.ViewStackTree
+	m.stackTreeList.SetLoading(true)
+	m.logger.Info("Loading all resources for stack %s...", stackName)
+
+	return tea.Batch(
+		m.stackTreeList.Spinner().TickCmd(),
+		func() tea.Msg {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			resources, err := m.client.ListStackResources(ctx, stackName)
+			return stackTreeLoadedMsg{stack: stackName, resources: resources, err: err}
+		},
+	)
+}
+
+// handleStackTreeLoaded applies a loaded resource listing.
+func (m *Model) handleStackTreeLoaded(msg stackTreeLoadedMsg) {
+	if msg.err != nil {
+		m.logger.Error("Failed to load resources for %s: %v", msg.stack, msg.err)
+		m.stackTreeList.SetLoading(false)
+		m.stackTreeList.SetError(msg.err)
+		return
+	}
+
+	m.stackTree = msg.resources
+	m.logger.Info("Loaded %d resource(s) in %s", len(msg.resources), msg.stack)
+	m.updateStackTreeList()
+}
+
+// stackTreeStatusStyle colors a resource row by its CloudFormation status.
+func stackTreeStatusStyle(status string) lipgloss.Style {
+	switch {
+	case strings.HasSuffix(status, "_FAILED") || strings.Contains(status, "ROLLBACK"):
+		return lipgloss.NewStyle().Foreground(theme.Error)
+	case strings.HasSuffix(status, "_COMPLETE"):
+		return lipgloss.NewStyle().Foreground(theme.Success)
+	default:
+		return lipgloss.NewStyle().Foreground(theme.Warning)
+	}
+}
+
+// updateStackTreeList renders the resources grouped by type, with a header
+// row per type.
+func (m *Model) updateStackTreeList() {
+	headerStyle := lipgloss.NewStyle().Foreground(theme.Info).Bold(true)
+
+	byType := make(map[string][]model.StackResource)
+	for _, r := range m.stackTree {
+		byType[r.Type] = append(byType[r.Type], r)
+	}
+	types := make([]string, 0, len(byType))
+	for t := range byType {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+
+	var items []components.ListItem
+	for _, t := range types {
+		group := byType[t]
+		sort.Slice(group, func(i, j int) bool { return group[i].LogicalID < group[j].LogicalID })
+
+		items = append(items, components.ListItem{
+			ID:          "hdr:" + t,
+			Title:       t,
+			Status:      fmt.Sprintf("%d", len(group)),
+			StatusStyle: headerStyle,
+		})
+		for _, r := range group {
+			items = append(items, components.ListItem{
+				ID:          "res:" + r.LogicalID,
+				Title:       "  " + r.LogicalID,
+				Status:      r.Status,
+				StatusStyle: stackTreeStatusStyle(r.Status),
+				Extra:       truncateString(r.PhysicalID, 40),
+			})
+		}
+	}
+
+	m.stackTreeList.SetItems(items)
+	m.stackTreeList.SetLoading(false)
+	m.stackTreeList.SetError(nil)
+	m.stackTreeList.SetEmptyMessage("No resources in this stack")
+	m.updateStackTreeDetails()
+}
+
+// updateStackTreeDetails shows the selected resource in the details pane.
+func (m *Model) updateStackTreeDetails() {
+	item := m.stackTreeList.SelectedItem()
+	if item == nil || !strings.HasPrefix(item.ID, "res:") {
+		m.details.SetTitle("Stack Resources")
+		m.details.SetRows(nil)
+		return
+	}
+
+	logicalID := strings.TrimPrefix(item.ID, "res:")
+	for _, r := range m.stackTree {
+		if r.LogicalID != logicalID {
+			continue
+		}
+		m.details.SetTitle("Resource Details")
+		m.details.SetRows([]components.DetailRow{
+			{Label: "Logical ID", Value: r.LogicalID},
+			{Label: "Type", Value: r.Type},
+			{Label: "Status", Value: r.Status, Style: stackTreeStatusStyle(r.Status)},
+			{Label: "", Value: ""}, // Spacer
+			{Label: "Physical ID", Value: r.PhysicalID},
+		})
+		return
+	}
+}
+
+// openStackTreeResource jumps to the selected resource's native view where
+// one exists, pre-filtered to its physical id.
+func (m *Model) openStackTreeResource() tea.Cmd {
+	item := m.stackTreeList.SelectedItem()
+	if item == nil || !strings.HasPrefix(item.ID, "res:") {
+		return nil
+	}
+
+	logicalID := strings.TrimPrefix(item.ID, "res:")
+	for _, r := range m.stackTree {
+		if r.LogicalID != logicalID {
+			continue
+		}
+
+		var filter string
+		var cmd tea.Cmd
+		switch r.Type {
+		case "AWS::Lambda::Function":
+			m.state.View = state.ViewLambda
+			filter = r.PhysicalID
+			cmd = m.loadFunctions()
+		case "AWS::SQS::Queue":
+			m.state.View = state.ViewSQS
+			filter = extractQueueNameFromURL(r.PhysicalID)
+			cmd = m.loadQueues()
+		case "AWS::DynamoDB::Table":
+			m.state.View = state.ViewDynamoDB
+			filter = r.PhysicalID
+			cmd = m.loadTables()
+		default:
+			m.logger.Info("No native view for %s - physical id: %s", r.Type, r.PhysicalID)
+			return nil
+		}
+
+		m.state.FilterText = filter
+		m.filterInput.SetValue(filter)
+		m.updateCurrentList()
+		return cmd
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package ui
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"vaws/internal/ui/theme"
+)
+
+// startStageVarEdit opens the key=value prompt for the selected stage.
+func (m *Model) startStageVarEdit() tea.Cmd {
+	item := m.apiStagesList.SelectedItem()
+	if item == nil {
+		m.logger.Warn("Stage variable: no stage selected")
+		return nil
+	}
+	if m.state.SelectedRestAPI == nil && m.state.SelectedHttpAPI == nil {
+		return nil
+	}
+
+	m.stageVarStage = item.ID
+	m.stageVarActive = true
+	m.stageVarInput.SetValue("")
+	m.stageVarInput.Focus()
+	return textinput.Blink
+}
+
+// handleStageVarKey handles the key=value prompt and applies the update.
+func (m *Model) handleStageVarKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "enter":
+		key, value, ok := strings.Cut(strings.TrimSpace(m.stageVarInput.Value()), "=")
+		if !ok || key == "" {
+			m.logger.Error("Enter a variable as key=value")
+			return nil
+		}
+
+		stageName := m.stageVarStage
+		m.stageVarActive = false
+		m.stageVarInput.Blur()
+
+		var apiID string
+		isRest := false
+		switch {
+		case m.state.SelectedRestAPI != nil:
+			apiID, isRest = m.state.SelectedRestAPI.ID, true
+		case m.state.SelectedHttpAPI != nil:
+			apiID = m.state.SelectedHttpAPI.ID
+		default:
+			return nil
+		}
+
+		m.logger.Info("Setting %s=%s on stage %s...", key, value, stageName)
+		return func() tea.Msg {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			var err error
+			if isRest {
+				err = m.client.UpdateRestStageVariable(ctx, apiID, stageName, key, value)
+			} else {
+				err = m.client.UpdateHTTPStageVariable(ctx, apiID, stageName, key, value)
+			}
+			return stageVarUpdatedMsg{stage: stageName, key: key, err: err}
+		}
+
+	case "esc":
+		m.stageVarActive = false
+		m.stageVarInput.Blur()
+		return nil
+	}
+
+	var cmd tea.Cmd
+	m.stageVarInput, cmd = m.stageVarInput.Update(msg)
+	return cmd
+}
+
+// renderStageVarDialog renders the stage variable prompt.
+func (m *Model) renderStageVarDialog() string {
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.BorderFocus).
+		Padding(1, 2).
+		Width(52)
+
+	labelStyle := lipgloss.NewStyle().Foreground(theme.Primary).Bold(true)
+	hintStyle := lipgloss.NewStyle().Foreground(theme.TextDim).Italic(true)
+
+	return dialogStyle.Render(
+		labelStyle.Render("Stage variable: "+m.stageVarStage) + "\n\n" +
+			"key=value: " + m.stageVarInput.View() + "\n\n" +
+			hintStyle.Render("Enter apply • Esc cancel"))
+}
+
+// handleStageVarUpdated refreshes the stages so the shown value is
+// authoritative rather than locally assumed.
+func (m *Model) handleStageVarUpdated(msg stageVarUpdatedMsg) tea.Cmd {
+	if msg.err != nil {
+		m.logger.Error("Failed to set %s on %s: %v", msg.key, msg.stage, msg.err)
+		m.state.ShowLogs = true
+		m.updateComponentSizes()
+		return nil
+	}
+	m.logger.Info("Stage variable %s updated on %s - refreshing", msg.key, msg.stage)
+	return m.loadAPIStages()
+}
@@ -0,0 +1,98 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"vaws/internal/model"
+	"vaws/internal/state"
+	"vaws/internal/ui/components"
+	"vaws/internal/ui/theme"
+)
+
+// svcEventsLimit is how many events the panel fetches.
+const svcEventsLimit = 20
+
+// loadServiceEvents fetches the freshest events for the selected service so
+// stuck deployments can be diagnosed in the details pane.
+func (m *Model) loadServiceEvents() tea.Cmd {
+	item := m.serviceList.SelectedItem()
+	if item == nil {
+		return nil
+	}
+
+	var selected *model.Service
+	for i := range m.state.Services {
+		if m.state.Services[i].Name == item.ID {
+			selected = &m.state.Services[i]
+			break
+		}
+	}
+	if selected == nil || selected.ClusterARN == "" {
+		return nil
+	}
+
+	clusterARN, name := selected.ClusterARN, selected.Name
+	m.logger.Info("Loading events for %s...", name)
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		events, err := m.client.GetServiceEvents(ctx, clusterARN, name, svcEventsLimit)
+		return serviceEventsMsg{service: name, events: events, err: err}
+	}
+}
+
+// serviceEventStyle colors event messages by severity keywords.
+func serviceEventStyle(message string) lipgloss.Style {
+	lower := strings.ToLower(message)
+	switch {
+	case strings.Contains(lower, "unable"), strings.Contains(lower, "failed"), strings.Contains(lower, "unhealthy"):
+		return lipgloss.NewStyle().Foreground(theme.Error)
+	default:
+		return lipgloss.NewStyle().Foreground(theme.TextMuted)
+	}
+}
+
+// svcEventDetailRows renders fetched events for a service, newest first.
+func (m *Model) svcEventDetailRows(serviceName string) []components.DetailRow {
+	events, ok := m.svcEvents[serviceName]
+	if !ok {
+		return nil
+	}
+
+	rows := []components.DetailRow{
+		{Label: "", Value: ""}, // Spacer
+		{Label: "Events", Value: fmt.Sprintf("%d (newest first)", len(events))},
+	}
+	for _, e := range events {
+		rows = append(rows, components.DetailRow{
+			Label: "  " + e.CreatedAt.Format("15:04:05"),
+			Value: e.Message,
+			Style: serviceEventStyle(e.Message),
+		})
+	}
+	return rows
+}
+
+// handleServiceEvents applies fetched events.
+func (m *Model) handleServiceEvents(msg serviceEventsMsg) {
+	if msg.err != nil {
+		m.logger.Error("Failed to load events for %s: %v", msg.service, msg.err)
+		return
+	}
+	if m.svcEvents == nil {
+		m.svcEvents = make(map[string][]model.ServiceEvent)
+	}
+	m.svcEvents[msg.service] = msg.events
+	m.logger.Info("Loaded %d event(s) for %s", len(msg.events), msg.service)
+	if m.state.View == state.ViewServices {
+		m.updateServiceDetails()
+	}
+}
@@ -0,0 +1,245 @@
+package ui
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"vaws/internal/ui/components"
+)
+
+// Tag target kinds the shared tag editor can be opened for.
+const (
+	tagKindQueue    = "queue"
+	tagKindFunction = "function"
+)
+
+// startQueueTagEdit fetches the selected queue's tags and opens the shared
+// key/value tag editor.
+func (m *Model) startQueueTagEdit() tea.Cmd {
+	queue := m.sqsTable.SelectedQueue()
+	if queue == nil {
+		m.logger.Warn("Tags: no queue selected")
+		return nil
+	}
+
+	url, name := queue.URL, queue.Name
+	m.logger.Info("Loading tags for %s...", name)
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		tags, err := m.client.GetQueueTags(ctx, url)
+		return tagsLoadedMsg{kind: tagKindQueue, id: url, name: name, tags: tags, err: err}
+	}
+}
+
+// startFunctionTagEdit fetches the selected function's tags and opens the
+// shared key/value tag editor. Lambda tagging is ARN-addressed.
+func (m *Model) startFunctionTagEdit() tea.Cmd {
+	item := m.lambdaList.SelectedItem()
+	if item == nil {
+		return nil
+	}
+
+	for i := range m.state.Functions {
+		if m.state.Functions[i].Name != item.ID {
+			continue
+		}
+		fn := m.state.Functions[i]
+		if fn.ARN == "" {
+			m.logger.Warn("Tags: no ARN for function %s", fn.Name)
+			return nil
+		}
+
+		m.logger.Info("Loading tags for %s...", fn.Name)
+		arn, name := fn.ARN, fn.Name
+		return func() tea.Msg {
+			ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+			defer cancel()
+
+			tags, err := m.client.GetFunctionTags(ctx, arn)
+			return tagsLoadedMsg{kind: tagKindFunction, id: arn, name: name, tags: tags, err: err}
+		}
+	}
+	return nil
+}
+
+// validateTags checks keys and values against the AWS tag constraints
+// shared by SQS and Lambda: keys 1-128 characters, values up to 256.
+func validateTags(tags map[string]string) error {
+	for k, v := range tags {
+		if len(k) == 0 || len(k) > 128 {
+			return fmt.Errorf("tag key %q must be 1-128 characters", k)
+		}
+		if len(v) > 256 {
+			return fmt.Errorf("tag value for %q must be at most 256 characters", k)
+		}
+	}
+	return nil
+}
+
+// handleTagsLoaded caches the fetched tags and opens the editor.
+func (m *Model) handleTagsLoaded(msg tagsLoadedMsg) tea.Cmd {
+	if msg.err != nil {
+		m.logger.Error("Failed to load tags for %s: %v", msg.name, msg.err)
+		return nil
+	}
+
+	if m.resourceTags == nil {
+		m.resourceTags = make(map[string]map[string]string)
+	}
+	m.resourceTags[msg.kind+":"+msg.id] = msg.tags
+	m.updateCurrentList()
+
+	tags := msg.tags
+	if tags == nil {
+		tags = map[string]string{}
+	}
+	initial, err := json.MarshalIndent(tags, "", "  ")
+	if err != nil {
+		m.logger.Error("Failed to render tags: %v", err)
+		return nil
+	}
+
+	m.tagEditKind = msg.kind
+	m.tagEditID = msg.id
+	m.tagEditName = msg.name
+	m.tagEditOriginal = msg.tags
+	m.editorPurpose = editorPurposeTags
+	m.structuredEditor.SetFormat(components.FormatJSON, false)
+	m.structuredEditor.SetSize(m.width, m.height)
+	return m.structuredEditor.Activate("Tags: "+msg.name, string(initial))
+}
+
+// handleTagsEditor routes keys to the tag editor and applies the diff on
+// submit: new and changed keys are tagged, removed keys untagged.
+func (m *Model) handleTagsEditor(msg tea.Msg) tea.Cmd {
+	result, cmd := m.structuredEditor.Update(msg)
+	if result == nil {
+		return cmd
+	}
+	if result.Cancelled {
+		m.tagEditKind = ""
+		return nil
+	}
+
+	var tags map[string]string
+	if err := json.Unmarshal([]byte(result.Value), &tags); err != nil {
+		m.logger.Error("Tags must be a flat JSON object of string values: %v", err)
+		m.tagEditKind = ""
+		return nil
+	}
+	if err := validateTags(tags); err != nil {
+		m.logger.Error("Invalid tags: %v", err)
+		m.tagEditKind = ""
+		return nil
+	}
+
+	kind, id, name := m.tagEditKind, m.tagEditID, m.tagEditName
+	original := m.tagEditOriginal
+	m.tagEditKind = ""
+
+	var removed []string
+	for k := range original {
+		if _, ok := tags[k]; !ok {
+			removed = append(removed, k)
+		}
+	}
+	changed := map[string]string{}
+	for k, v := range tags {
+		if ov, ok := original[k]; !ok || ov != v {
+			changed[k] = v
+		}
+	}
+	if len(removed) == 0 && len(changed) == 0 {
+		m.logger.Info("No tag changes for %s", name)
+		return nil
+	}
+
+	m.logger.Info("Updating tags for %s (%d set, %d removed)...", name, len(changed), len(removed))
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		var err error
+		switch kind {
+		case tagKindQueue:
+			if err = m.client.TagQueue(ctx, id, changed); err == nil {
+				err = m.client.UntagQueue(ctx, id, removed)
+			}
+		case tagKindFunction:
+			if err = m.client.TagFunction(ctx, id, changed); err == nil {
+				err = m.client.UntagFunction(ctx, id, removed)
+			}
+		}
+		return tagsSavedMsg{kind: kind, id: id, name: name, tags: tags, err: err}
+	}
+}
+
+// handleTagsSaved applies a completed tag update to the cache and details.
+func (m *Model) handleTagsSaved(msg tagsSavedMsg) {
+	if msg.err != nil {
+		m.logger.Error("Failed to update tags for %s: %v", msg.name, msg.err)
+		m.state.ShowLogs = true
+		m.updateComponentSizes()
+		return
+	}
+
+	if m.resourceTags == nil {
+		m.resourceTags = make(map[string]map[string]string)
+	}
+	m.resourceTags[msg.kind+":"+msg.id] = msg.tags
+
+	// Keep the loaded function model in sync so its details stay current
+	if msg.kind == tagKindFunction {
+		for i := range m.state.Functions {
+			if m.state.Functions[i].ARN == msg.id {
+				m.state.Functions[i].Tags = msg.tags
+				break
+			}
+		}
+	}
+
+	m.logger.Info("Tags updated for %s", msg.name)
+	m.updateCurrentList()
+}
+
+// tagDetailRows renders cached tags for a resource's detail panel. Tags show
+// up after the editor fetched them once (T); nil means not fetched yet.
+func (m *Model) tagDetailRows(kind, id string) []components.DetailRow {
+	tags, ok := m.resourceTags[kind+":"+id]
+	if !ok {
+		return nil
+	}
+	return tagRows(tags)
+}
+
+// tagRows renders a tag map as detail rows, sorted by key.
+func tagRows(tags map[string]string) []components.DetailRow {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	rows := []components.DetailRow{
+		{Label: "", Value: ""}, // Spacer
+		{Label: "Tags", Value: ""},
+	}
+	for _, k := range keys {
+		rows = append(rows, components.DetailRow{
+			Label: "  " + truncateString(k, 14),
+			Value: tags[k],
+		})
+	}
+	return rows
+}
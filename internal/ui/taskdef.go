@@ -0,0 +1,139 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"vaws/internal/model"
+	"vaws/internal/state"
+	"vaws/internal/ui/components"
+	"vaws/internal/ui/theme"
+)
+
+// loadTaskDefinition fetches the selected service's active task definition
+// for display beneath its details.
+func (m *Model) loadTaskDefinition() tea.Cmd {
+	item := m.serviceList.SelectedItem()
+	if item == nil {
+		return nil
+	}
+
+	var selected *model.Service
+	for i := range m.state.Services {
+		if m.state.Services[i].Name == item.ID {
+			selected = &m.state.Services[i]
+			break
+		}
+	}
+	if selected == nil || selected.TaskDefinition == "" {
+		return nil
+	}
+
+	name, taskDefARN := selected.Name, selected.TaskDefinition
+	m.logger.Info("Loading task definition for %s...", name)
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		def, err := m.client.DescribeTaskDefinition(ctx, taskDefARN)
+		return taskDefMsg{service: name, def: def, err: err}
+	}
+}
+
+// deploymentRevisionSplit reports the PRIMARY and ACTIVE deployment
+// revisions when the service is mid-rollout with diverging task defs.
+func deploymentRevisionSplit(svc *model.Service) (primary, active string, split bool) {
+	for _, d := range svc.Deployments {
+		rev := d.TaskDefinition
+		if i := strings.LastIndex(rev, "/"); i >= 0 {
+			rev = rev[i+1:]
+		}
+		switch d.Status {
+		case "PRIMARY":
+			primary = rev
+		case "ACTIVE":
+			active = rev
+		}
+	}
+	return primary, active, primary != "" && active != "" && primary != active
+}
+
+// taskDefDetailRows renders the fetched task definition for a service.
+func (m *Model) taskDefDetailRows(svc *model.Service) []components.DetailRow {
+	def, ok := m.svcTaskDefs[svc.Name]
+	if !ok {
+		return nil
+	}
+
+	rows := []components.DetailRow{
+		{Label: "", Value: ""}, // Spacer
+		{Label: "Task Def", Value: fmt.Sprintf("%s:%s", def.Family, def.Revision)},
+	}
+	if def.CPU != "" || def.Memory != "" {
+		rows = append(rows, components.DetailRow{
+			Label: "  size",
+			Value: fmt.Sprintf("cpu %s / mem %s", def.CPU, def.Memory),
+		})
+	}
+
+	// Highlight a mid-rollout revision split so a stale ACTIVE deployment
+	// doesn't get mistaken for the running code
+	if primary, active, split := deploymentRevisionSplit(svc); split {
+		rows = append(rows, components.DetailRow{
+			Label: "  ⚠ rollout",
+			Value: fmt.Sprintf("PRIMARY %s vs ACTIVE %s", primary, active),
+			Style: lipgloss.NewStyle().Foreground(theme.Warning),
+		})
+	}
+
+	for _, container := range def.Containers {
+		rows = append(rows, components.DetailRow{
+			Label: "  " + truncateString(container.Name, 14),
+			Value: container.Image, // copy with 'Y' (yank details)
+		})
+		extras := []string{}
+		if container.Memory > 0 {
+			extras = append(extras, fmt.Sprintf("mem %d", container.Memory))
+		}
+		if len(container.Ports) > 0 {
+			ports := make([]string, len(container.Ports))
+			for i, p := range container.Ports {
+				ports[i] = fmt.Sprintf("%d", p)
+			}
+			extras = append(extras, "ports "+strings.Join(ports, ","))
+		}
+		if len(container.Environment) > 0 {
+			extras = append(extras, fmt.Sprintf("%d env vars", len(container.Environment)))
+		}
+		if len(extras) > 0 {
+			rows = append(rows, components.DetailRow{
+				Label: "    ",
+				Value: strings.Join(extras, " • "),
+				Style: lipgloss.NewStyle().Foreground(theme.TextDim),
+			})
+		}
+	}
+	return rows
+}
+
+// handleTaskDef applies a fetched task definition.
+func (m *Model) handleTaskDef(msg taskDefMsg) {
+	if msg.err != nil {
+		m.logger.Error("Failed to load task definition for %s: %v", msg.service, msg.err)
+		return
+	}
+	if m.svcTaskDefs == nil {
+		m.svcTaskDefs = make(map[string]*model.TaskDefinition)
+	}
+	m.svcTaskDefs[msg.service] = msg.def
+	m.logger.Info("Loaded task definition %s:%s", msg.def.Family, msg.def.Revision)
+	if m.state.View == state.ViewServices {
+		m.updateServiceDetails()
+	}
+}
@@ -0,0 +1,186 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"vaws/internal/model"
+	"vaws/internal/state"
+	"vaws/internal/ui/components"
+	"vaws/internal/ui/theme"
+)
+
+// openTaskList loads the selected service's tasks and switches to the task
+// level between services and containers.
+func (m *Model) openTaskList() tea.Cmd {
+	item := m.serviceList.SelectedItem()
+	if item == nil {
+		return nil
+	}
+
+	var selected *model.Service
+	for i := range m.state.Services {
+		if m.state.Services[i].Name == item.ID {
+			selected = &m.state.Services[i]
+			break
+		}
+	}
+	if selected == nil || selected.ClusterARN == "" {
+		return nil
+	}
+
+	m.state.SelectService(selected)
+	m.state.View = state.ViewTasks
+	return m.refreshTaskList()
+}
+
+// refreshTaskList re-fetches tasks for the already-selected service, used
+// both when entering the task level and for manual refresh.
+func (m *Model) refreshTaskList() tea.Cmd {
+	if m.state.SelectedService == nil {
+		return nil
+	}
+	m.tasksList.SetLoading(true)
+
+	service := *m.state.SelectedService
+	return tea.Batch(
+		m.tasksList.Spinner().TickCmd(),
+		func() tea.Msg {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			tasks, err := m.client.ListTasksForService(ctx, service.ClusterARN, service.Name)
+			return taskListMsg{service: service, tasks: tasks, err: err}
+		},
+	)
+}
+
+// taskStatusStyle colors a task row by its last status and health.
+func taskStatusStyle(task *model.Task) lipgloss.Style {
+	switch {
+	case task.HealthStatus == "UNHEALTHY":
+		return lipgloss.NewStyle().Foreground(theme.Error)
+	case task.LastStatus == "RUNNING":
+		return lipgloss.NewStyle().Foreground(theme.Success)
+	case task.LastStatus == "PENDING":
+		return lipgloss.NewStyle().Foreground(theme.Warning)
+	default:
+		return lipgloss.NewStyle().Foreground(theme.TextMuted)
+	}
+}
+
+// updateTasksList renders the task-level overview.
+func (m *Model) updateTasksList() {
+	tasks := m.state.FilteredTasks()
+	items := make([]components.ListItem, len(tasks))
+	for i := range tasks {
+		task := &tasks[i]
+		status := task.LastStatus
+		if task.HealthStatus != "" && task.HealthStatus != "UNKNOWN" {
+			status += " " + task.HealthStatus
+		}
+		items[i] = components.ListItem{
+			ID:          task.TaskID,
+			Title:       truncateString(task.TaskID, 32),
+			Status:      status,
+			StatusStyle: taskStatusStyle(task),
+			Extra:       task.AvailabilityZone,
+		}
+	}
+	m.tasksList.SetItems(items)
+	m.tasksList.SetLoading(false)
+	m.tasksList.SetError(m.state.TasksError)
+	m.tasksList.SetEmptyMessage("No tasks found for this service")
+	m.updateTaskDetails()
+}
+
+// updateTaskDetails shows the selected task in the details pane.
+func (m *Model) updateTaskDetails() {
+	item := m.tasksList.SelectedItem()
+	if item == nil {
+		m.details.SetTitle("Task Details")
+		m.details.SetRows(nil)
+		return
+	}
+
+	for i := range m.state.Tasks {
+		task := &m.state.Tasks[i]
+		if task.TaskID != item.ID {
+			continue
+		}
+
+		rows := []components.DetailRow{
+			{Label: "Task", Value: task.TaskID},
+			{Label: "Status", Value: task.LastStatus, Style: taskStatusStyle(task)},
+		}
+		if task.HealthStatus != "" {
+			rows = append(rows, components.DetailRow{Label: "Health", Value: task.HealthStatus})
+		}
+		if task.AvailabilityZone != "" {
+			rows = append(rows, components.DetailRow{Label: "AZ", Value: task.AvailabilityZone})
+		}
+		rows = append(rows, components.DetailRow{Label: "Launch Type", Value: task.LaunchType})
+		if !task.StartedAt.IsZero() {
+			rows = append(rows, components.DetailRow{Label: "Started", Value: task.StartedAt.Format("2006-01-02 15:04:05")})
+		}
+		rows = append(rows, components.DetailRow{Label: "Containers", Value: fmt.Sprintf("%d", len(task.Containers))})
+
+		m.details.SetTitle("Task Details")
+		m.details.SetRows(rows)
+		return
+	}
+}
+
+// drillIntoTaskContainers opens the container picker for the selected task,
+// reusing the port-forward selection flow.
+func (m *Model) drillIntoTaskContainers() tea.Cmd {
+	item := m.tasksList.SelectedItem()
+	if item == nil || m.state.SelectedService == nil {
+		return nil
+	}
+
+	for i := range m.state.Tasks {
+		task := m.state.Tasks[i]
+		if task.TaskID != item.ID {
+			continue
+		}
+
+		var containersWithRuntime []model.Container
+		for _, c := range task.Containers {
+			if c.RuntimeID != "" {
+				containersWithRuntime = append(containersWithRuntime, c)
+			}
+		}
+		if len(containersWithRuntime) == 0 {
+			m.logger.Warn("Task %s has no containers with a RuntimeID", task.TaskID)
+			return nil
+		}
+
+		m.state.PendingContainerService = m.state.SelectedService
+		m.state.PendingContainerTask = &m.state.Tasks[i]
+		m.state.PendingContainers = containersWithRuntime
+		m.pendingLocalPort = 0
+		m.state.View = state.ViewContainerSelect
+		m.updateContainerList()
+		return nil
+	}
+	return nil
+}
+
+// handleTaskList applies a loaded task list.
+func (m *Model) handleTaskList(msg taskListMsg) {
+	m.state.TasksLoading = false
+	if msg.err != nil {
+		m.state.TasksError = msg.err
+		m.logger.Error("Failed to load tasks for %s: %v", msg.service.Name, msg.err)
+	} else {
+		m.state.Tasks = msg.tasks
+		m.state.TasksError = nil
+		m.logger.Info("Loaded %d task(s) for %s", len(msg.tasks), msg.service.Name)
+	}
+	m.updateTasksList()
+}
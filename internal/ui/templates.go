@@ -0,0 +1,218 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"vaws/internal/config"
+	"vaws/internal/model"
+	"vaws/internal/state"
+	"vaws/internal/ui/components"
+	"vaws/internal/ui/theme"
+)
+
+// showQueryTemplates opens the template picker for the selected table.
+func (m *Model) showQueryTemplates() tea.Cmd {
+	table := m.dynamodbTable.SelectedTable()
+	if table == nil {
+		m.logger.Warn("Templates: no table selected")
+		return nil
+	}
+
+	var templates []config.QueryTemplate
+	if m.cfg != nil {
+		templates = m.cfg.TemplatesForTable(table.Name)
+	}
+	if len(templates) == 0 {
+		m.logger.Info("No query templates for table %s - add query_templates entries to ~/.vaws/config.yaml", table.Name)
+		return nil
+	}
+
+	m.state.SelectTable(table)
+	m.queryTemplates = templates
+	m.state.View = state.ViewQueryTemplates
+	m.updateTemplatesList()
+	return nil
+}
+
+// updateTemplatesList renders the available templates for the selected table.
+func (m *Model) updateTemplatesList() {
+	items := make([]components.ListItem, len(m.queryTemplates))
+	for i, tpl := range m.queryTemplates {
+		desc := tpl.PartitionKeyVal
+		if tpl.SortKeyVal != "" {
+			desc += " / " + tpl.SortKeyVal
+		}
+		items[i] = components.ListItem{
+			ID:          fmt.Sprintf("template:%d", i),
+			Title:       tpl.Name,
+			Status:      desc,
+			StatusStyle: lipgloss.NewStyle().Foreground(theme.Info),
+			Extra:       tpl.IndexName,
+		}
+	}
+	m.templatesList.SetItems(items)
+	m.templatesList.SetLoading(false)
+	m.templatesList.SetError(nil)
+	m.templatesList.SetEmptyMessage("No templates for this table")
+	m.templatesList.Top()
+}
+
+// selectQueryTemplate starts running the template at the cursor, prompting
+// for placeholder values first when the template has any.
+func (m *Model) selectQueryTemplate() tea.Cmd {
+	idx := m.templatesList.Cursor()
+	if idx < 0 || idx >= len(m.queryTemplates) {
+		return nil
+	}
+	tpl := m.queryTemplates[idx]
+	m.pendingTemplate = &tpl
+	m.templateParams = make(map[string]string)
+	m.templateParamQueue = templatePlaceholders(tpl)
+
+	if len(m.templateParamQueue) == 0 {
+		return m.runQueryTemplate()
+	}
+	return m.promptNextTemplateParam()
+}
+
+// templatePlaceholders returns the :placeholders in a template, in prompt order.
+func templatePlaceholders(tpl config.QueryTemplate) []string {
+	var out []string
+	seen := make(map[string]bool)
+	for _, v := range []string{tpl.PartitionKeyVal, tpl.SortKeyVal} {
+		if strings.HasPrefix(v, ":") && !seen[v] {
+			out = append(out, v)
+			seen[v] = true
+		}
+	}
+	return out
+}
+
+// promptNextTemplateParam opens the input dialog for the next placeholder.
+func (m *Model) promptNextTemplateParam() tea.Cmd {
+	m.enteringTemplateParam = true
+	m.templateParamInput.SetValue("")
+	m.templateParamInput.Placeholder = "Value for " + m.templateParamQueue[0]
+	m.templateParamInput.Focus()
+	return textinput.Blink
+}
+
+// handleTemplateParamKey handles key messages while a placeholder prompt is open.
+func (m *Model) handleTemplateParamKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "enter":
+		value := strings.TrimSpace(m.templateParamInput.Value())
+		if value == "" {
+			return nil // placeholders are required
+		}
+		m.templateParams[m.templateParamQueue[0]] = value
+		m.templateParamQueue = m.templateParamQueue[1:]
+
+		if len(m.templateParamQueue) > 0 {
+			return m.promptNextTemplateParam()
+		}
+		m.enteringTemplateParam = false
+		m.templateParamInput.Blur()
+		return m.runQueryTemplate()
+
+	case "esc":
+		m.enteringTemplateParam = false
+		m.templateParamInput.Blur()
+		m.pendingTemplate = nil
+		return nil
+	}
+
+	var cmd tea.Cmd
+	m.templateParamInput, cmd = m.templateParamInput.Update(msg)
+	return cmd
+}
+
+// runQueryTemplate fills the pending template's placeholders and executes it.
+func (m *Model) runQueryTemplate() tea.Cmd {
+	tpl := m.pendingTemplate
+	if tpl == nil {
+		return nil
+	}
+	m.pendingTemplate = nil
+
+	expand := func(v string) string {
+		if val, ok := m.templateParams[v]; ok {
+			return val
+		}
+		return v
+	}
+
+	table := m.state.SelectedTable
+	pkName := tpl.PartitionKeyName
+	if pkName == "" && table != nil {
+		pkName = table.PartitionKey()
+	}
+	skName := tpl.SortKeyName
+	if skName == "" && tpl.SortKeyVal != "" && table != nil {
+		skName = table.SortKey()
+	}
+
+	params := &model.QueryParams{
+		TableName:        tpl.Table,
+		PartitionKeyName: pkName,
+		PartitionKeyVal:  expand(tpl.PartitionKeyVal),
+		SortKeyName:      skName,
+		SortKeyVal:       expand(tpl.SortKeyVal),
+		SortKeyCondition: model.SortKeyCondition(tpl.SortKeyCondition),
+		Limit:            tpl.Limit,
+		ScanIndexForward: true,
+		IndexName:        tpl.IndexName,
+	}
+
+	m.state.DynamoDBLastKey = nil
+	m.state.View = state.ViewDynamoDBQuery
+	m.dynamodbQueryResults.Clear()
+	m.logger.Info("Running template %q on table %s", tpl.Name, tpl.Table)
+	return m.executeDynamoDBQuery(params)
+}
+
+// renderTemplateParamDialog renders the placeholder value prompt.
+func (m *Model) renderTemplateParamDialog() string {
+	dialogWidth := 50
+	if m.width < 60 {
+		dialogWidth = m.width - 10
+		if dialogWidth < 30 {
+			dialogWidth = 30
+		}
+	}
+
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.BorderFocus).
+		Padding(1, 2).
+		Width(dialogWidth)
+
+	labelStyle := lipgloss.NewStyle().
+		Foreground(theme.Primary).
+		Bold(true)
+
+	hintStyle := lipgloss.NewStyle().
+		Foreground(theme.TextDim).
+		Italic(true)
+
+	templateName := ""
+	if m.pendingTemplate != nil {
+		templateName = m.pendingTemplate.Name
+	}
+
+	placeholder := ""
+	if len(m.templateParamQueue) > 0 {
+		placeholder = m.templateParamQueue[0]
+	}
+
+	dialogContent := labelStyle.Render("Template: "+templateName) + "\n\n" +
+		placeholder + " = " + m.templateParamInput.View() + "\n\n" +
+		hintStyle.Render("Enter value, Esc to cancel")
+
+	return dialogStyle.Render(dialogContent)
+}
@@ -119,12 +119,16 @@ func Set(t Theme) {
 	current = t
 }
 
-// SetByName sets the theme by name.
+// SetByName sets the theme by name. Forcing dark or light also pins the
+// lipgloss background assumption, so every component's adaptive colors
+// resolve through the chosen theme instead of terminal detection.
 func SetByName(name ThemeType) {
 	switch name {
 	case ThemeLight:
+		lipgloss.SetHasDarkBackground(false)
 		Set(LightTheme)
 	case ThemeDark:
+		lipgloss.SetHasDarkBackground(true)
 		Set(DarkTheme)
 	case ThemeAuto:
 		Set(Detect())
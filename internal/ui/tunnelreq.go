@@ -0,0 +1,208 @@
+package ui
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"vaws/internal/ui/components"
+	"vaws/internal/ui/theme"
+)
+
+// Tunnel request builder stages.
+const (
+	tunnelReqStageNone    = iota
+	tunnelReqStageLine    // method and path
+	tunnelReqStageHeaders // optional headers
+)
+
+// tunnelReqMaxBodyLines bounds how much of a response body the log shows.
+const tunnelReqMaxBodyLines = 30
+
+// startTunnelRequest opens the request builder for the selected tunnel.
+func (m *Model) startTunnelRequest() tea.Cmd {
+	port := 0
+	defaultPath := "/"
+
+	if t := m.tunnelsPanel.SelectedTunnel(); t != nil {
+		port = t.LocalPort
+	} else if t := m.tunnelsPanel.SelectedAPIGatewayTunnel(); t != nil {
+		port = t.LocalPort
+		// Match the deployed stage so the path hits real routes
+		if t.StageName != "" {
+			defaultPath = "/" + t.StageName + "/"
+		}
+	}
+	if port == 0 {
+		m.logger.Warn("Request: no tunnel selected")
+		return nil
+	}
+
+	m.tunnelReqPort = port
+	m.tunnelReqStage = tunnelReqStageLine
+	m.tunnelReqInput.SetValue("GET " + defaultPath)
+	m.tunnelReqInput.Placeholder = "METHOD /path"
+	m.tunnelReqInput.Focus()
+	return textinput.Blink
+}
+
+// handleTunnelReqKey drives the method/path and headers prompts.
+func (m *Model) handleTunnelReqKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "enter":
+		value := strings.TrimSpace(m.tunnelReqInput.Value())
+		switch m.tunnelReqStage {
+		case tunnelReqStageLine:
+			parts := strings.Fields(value)
+			if len(parts) != 2 || !strings.HasPrefix(parts[1], "/") {
+				m.logger.Error("Enter a method and absolute path, e.g. GET /prod/health")
+				return nil
+			}
+			m.tunnelReqMethod = strings.ToUpper(parts[0])
+			m.tunnelReqPath = parts[1]
+			m.tunnelReqStage = tunnelReqStageHeaders
+			m.tunnelReqInput.SetValue("")
+			m.tunnelReqInput.Placeholder = "Headers (K: V; K2: V2) - Enter to skip"
+			return textinput.Blink
+
+		case tunnelReqStageHeaders:
+			m.tunnelReqHeaders = value
+			m.tunnelReqStage = tunnelReqStageNone
+			m.tunnelReqInput.Blur()
+
+			// Methods that carry a body go through the editor first
+			switch m.tunnelReqMethod {
+			case "POST", "PUT", "PATCH":
+				m.editorPurpose = editorPurposeTunnelReq
+				m.structuredEditor.SetFormat(components.FormatText, false)
+				m.structuredEditor.SetSize(m.width, m.height)
+				return m.structuredEditor.Activate(
+					fmt.Sprintf("%s %s body", m.tunnelReqMethod, m.tunnelReqPath), "")
+			}
+			return m.sendTunnelRequest("")
+		}
+		return nil
+
+	case "esc":
+		m.tunnelReqStage = tunnelReqStageNone
+		m.tunnelReqInput.Blur()
+		return nil
+	}
+
+	var cmd tea.Cmd
+	m.tunnelReqInput, cmd = m.tunnelReqInput.Update(msg)
+	return cmd
+}
+
+// handleTunnelReqEditor collects the request body and sends.
+func (m *Model) handleTunnelReqEditor(msg tea.Msg) tea.Cmd {
+	result, cmd := m.structuredEditor.Update(msg)
+	if result == nil {
+		return cmd
+	}
+	if result.Cancelled {
+		return nil
+	}
+	return m.sendTunnelRequest(result.Value)
+}
+
+// sendTunnelRequest fires the built request at the tunnel's local port.
+func (m *Model) sendTunnelRequest(body string) tea.Cmd {
+	method, path, headers, port := m.tunnelReqMethod, m.tunnelReqPath, m.tunnelReqHeaders, m.tunnelReqPort
+	m.logger.Info("%s http://localhost:%d%s ...", method, port, path)
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		url := fmt.Sprintf("http://localhost:%d%s", port, path)
+		req, err := http.NewRequestWithContext(ctx, method, url, strings.NewReader(body))
+		if err != nil {
+			return tunnelReqMsg{err: err}
+		}
+		for _, header := range strings.Split(headers, ";") {
+			if name, value, ok := strings.Cut(header, ":"); ok {
+				req.Header.Set(strings.TrimSpace(name), strings.TrimSpace(value))
+			}
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return tunnelReqMsg{err: err}
+		}
+		defer resp.Body.Close()
+
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+		return tunnelReqMsg{
+			status:      resp.Status,
+			contentType: resp.Header.Get("Content-Type"),
+			body:        string(data),
+		}
+	}
+}
+
+// handleTunnelReq renders the response into the log panel.
+func (m *Model) handleTunnelReq(msg tunnelReqMsg) {
+	if msg.err != nil {
+		m.logger.Error("Request failed: %v", msg.err)
+		m.state.ShowLogs = true
+		m.updateComponentSizes()
+		return
+	}
+
+	m.logger.Info("Response: %s (%s)", msg.status, msg.contentType)
+
+	// Pretty-print JSON bodies, bounded so the log stays usable
+	body := msg.body
+	var out interface{}
+	if json.Unmarshal([]byte(body), &out) == nil {
+		var buf bytes.Buffer
+		if json.Indent(&buf, []byte(body), "", "  ") == nil {
+			body = buf.String()
+		}
+	}
+	lines := strings.Split(body, "\n")
+	for i, line := range lines {
+		if i >= tunnelReqMaxBodyLines {
+			m.logger.Info("... (%d more lines)", len(lines)-tunnelReqMaxBodyLines)
+			break
+		}
+		m.logger.Info("%s", line)
+	}
+
+	m.state.ShowLogs = true
+	m.updateComponentSizes()
+}
+
+// renderTunnelReqDialog renders the method/path and headers prompts.
+func (m *Model) renderTunnelReqDialog() string {
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.BorderFocus).
+		Padding(1, 2).
+		Width(56)
+
+	labelStyle := lipgloss.NewStyle().Foreground(theme.Primary).Bold(true)
+	hintStyle := lipgloss.NewStyle().Foreground(theme.TextDim).Italic(true)
+
+	label := "Request"
+	hint := "Method and path against the tunnel's local port"
+	if m.tunnelReqStage == tunnelReqStageHeaders {
+		label = fmt.Sprintf("%s %s", m.tunnelReqMethod, m.tunnelReqPath)
+		hint = "Optional headers - Enter to skip"
+	}
+
+	return dialogStyle.Render(
+		labelStyle.Render(fmt.Sprintf("%s (localhost:%d)", label, m.tunnelReqPort)) + "\n\n" +
+			m.tunnelReqInput.View() + "\n\n" +
+			hintStyle.Render(hint+" • Esc cancel"))
+}
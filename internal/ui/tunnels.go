@@ -3,6 +3,9 @@ package ui
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -22,6 +25,195 @@ func (m *Model) updateTunnelsPanel() {
 	m.tunnelsPanel.SetAPIGatewayTunnels(apiGWTunnels)
 }
 
+// applyTunnelConfig pushes the config tunnels section onto a freshly
+// created tunnel manager.
+func (m *Model) applyTunnelConfig() {
+	if m.cfg == nil || m.tunnelManager == nil {
+		return
+	}
+	m.tunnelManager.SetReconnectPolicy(m.cfg.Tunnels.ReconnectAttempts, m.cfg.ReconnectBackoff())
+}
+
+// restorePreviousTunnels re-establishes tunnels that died with the previous
+// session, reusing the restart flow so the saved local ports stay stable.
+// Tunnels whose service has no running tasks are reported, not retried.
+func (m *Model) restorePreviousTunnels() tea.Cmd {
+	restorable := m.tunnelManager.RestorableTunnels()
+	if len(restorable) == 0 {
+		m.logger.Info("No tunnels from a previous session to restore")
+		return nil
+	}
+
+	var cmds []tea.Cmd
+	for _, t := range restorable {
+		if t.ClusterARN == "" {
+			m.logger.Warn("Cannot restore tunnel %s: missing cluster ARN", t.ID)
+			continue
+		}
+		info, err := m.tunnelManager.PrepareRestart(t.ID)
+		if err != nil {
+			m.logger.Warn("Cannot restore tunnel %s: %v", t.ID, err)
+			continue
+		}
+
+		tunnelInfo := *info
+		cmds = append(cmds, func() tea.Msg {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			tasks, err := m.client.ListTasksForService(ctx, tunnelInfo.ClusterARN, tunnelInfo.ServiceName)
+			return tasksLoadedMsgForRestart{tunnelInfo: tunnelInfo, tasks: tasks, err: err}
+		})
+	}
+	m.tunnelManager.ClearRestorable()
+
+	if len(cmds) == 0 {
+		return nil
+	}
+	m.logger.Info("Restoring %d tunnel(s) from the previous session...", len(cmds))
+	return tea.Batch(cmds...)
+}
+
+// copySelectedTunnel copies a paste-ready address for the tunnel under the
+// cursor: localhost:PORT for service tunnels, the local base URL for API
+// Gateway tunnels (with the stage path for public proxies; the private proxy
+// prepends the stage itself). Falls back to printing the address when no
+// clipboard is available (e.g. a headless SSH session).
+func (m *Model) copySelectedTunnel() {
+	var text string
+	if t := m.tunnelsPanel.SelectedTunnel(); t != nil {
+		text = fmt.Sprintf("localhost:%d", t.LocalPort)
+	} else if t := m.tunnelsPanel.SelectedAPIGatewayTunnel(); t != nil {
+		text = fmt.Sprintf("http://localhost:%d", t.LocalPort)
+		if t.TunnelType == model.APIGatewayTunnelPublic && t.StageName != "" {
+			text += "/" + t.StageName
+		}
+	}
+
+	if text == "" {
+		m.logger.Warn("No tunnel selected")
+		return
+	}
+	if err := copyToClipboard(text); err != nil {
+		m.logger.Warn("Clipboard not available (%v) - address: %s", err, text)
+		return
+	}
+	m.logger.Info("Copied %s to clipboard", text)
+}
+
+// copyTunnelEndpoints copies a formatted list of all active tunnel endpoints
+// (SSM and API Gateway) to the clipboard for pasting into docs or chat.
+func (m *Model) copyTunnelEndpoints() {
+	var b strings.Builder
+
+	for _, t := range m.tunnelManager.GetTunnels() {
+		if t.Status != model.TunnelStatusActive && t.Status != model.TunnelStatusStarting {
+			continue
+		}
+		b.WriteString(fmt.Sprintf("%s/%s -> localhost:%d (remote %d)\n",
+			t.ServiceName, t.ContainerName, t.LocalPort, t.RemotePort))
+	}
+
+	if m.apiGWManager != nil {
+		for _, t := range m.apiGWManager.GetTunnels() {
+			if t.Status != model.TunnelStatusActive && t.Status != model.TunnelStatusStarting {
+				continue
+			}
+			visibility := "public"
+			if t.TunnelType == model.APIGatewayTunnelPrivate {
+				visibility = "private"
+			}
+			b.WriteString(fmt.Sprintf("%s/%s (%s) -> http://localhost:%d (%s)\n",
+				t.APIName, t.StageName, visibility, t.LocalPort, t.InvokeURL))
+		}
+	}
+
+	text := b.String()
+	if text == "" {
+		m.logger.Warn("No active tunnels to copy")
+		return
+	}
+	if err := copyToClipboard(text); err != nil {
+		m.logger.Warn("Clipboard not available: %v", err)
+		return
+	}
+	m.logger.Info("Copied %d tunnel endpoint(s) to clipboard", strings.Count(text, "\n"))
+}
+
+// exportTunnels writes the active tunnel set as a runnable shell script
+// ("sh") of aws ssm start-session commands, or as SSH config LocalForward
+// entries ("ssh") for jump-host-based forwards.
+func (m *Model) exportTunnels(format, path string) {
+	var content, defaultName string
+	var mode os.FileMode = 0644
+
+	switch format {
+	case "sh", "script":
+		content = m.tunnelManager.ExportScript()
+		defaultName = "tunnels.sh"
+		mode = 0755
+	case "ssh":
+		if m.apiGWManager != nil {
+			content = m.apiGWManager.ExportSSHConfig()
+		}
+		defaultName = "tunnels.ssh_config"
+	default:
+		m.logger.Error("Unknown export format %q (use sh or ssh)", format)
+		return
+	}
+
+	if content == "" {
+		m.logger.Warn("No active tunnels to export as %s", format)
+		return
+	}
+
+	if path == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			m.logger.Error("Failed to resolve home directory: %v", err)
+			return
+		}
+		path = filepath.Join(home, ".vaws", defaultName)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		m.logger.Error("Failed to create directory for %s: %v", path, err)
+		return
+	}
+	if err := os.WriteFile(path, []byte(content), mode); err != nil {
+		m.logger.Error("Failed to write tunnel export: %v", err)
+		return
+	}
+
+	m.logger.Info("Exported tunnels to %s", path)
+}
+
+// checkTunnelTarget verifies what the tunnel will run against before it
+// starts: for EC2-launch tasks, that the container instance is SSM-managed
+// (the tunnel fails otherwise); for Fargate tasks it reports the platform
+// version.
+func (m *Model) checkTunnelTarget(service model.Service, task model.Task) tea.Cmd {
+	if task.LaunchType != "EC2" {
+		if task.PlatformVersion != "" {
+			m.logger.Info("Fargate task on platform version %s", task.PlatformVersion)
+		}
+		return nil
+	}
+	if task.ContainerInstanceARN == "" {
+		return nil
+	}
+
+	clusterARN := service.ClusterARN
+	containerInstanceARN := task.ContainerInstanceARN
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+
+		instanceID, managed, err := m.client.CheckContainerInstanceSSM(ctx, clusterARN, containerInstanceARN)
+		return tunnelTargetCheckedMsg{instanceID: instanceID, managed: managed, err: err}
+	}
+}
+
 // startTunnel starts a tunnel with a random local port.
 func (m *Model) startTunnel(service model.Service, task model.Task, container model.Container, remotePort int) tea.Cmd {
 	return m.startTunnelWithPort(service, task, container, remotePort, 0)
@@ -2,7 +2,12 @@
 package ui
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
@@ -52,29 +57,31 @@ type Model struct {
 	state *state.State
 
 	// UI components
-	splash              *components.Splash
-	mainMenuList        *components.List // Main menu with resource type selection
-	stacksList          *components.List
-	stackResourcesList  *components.List
-	clustersList        *components.List // ECS clusters list
-	serviceList         *components.List
-	lambdaList          *components.List
-	apiGatewayList      *components.List
-	apiStagesList       *components.List
-	ec2List             *components.List            // For jump host selection
-	containerList       *components.List            // For container selection in port forwarding
+	splash               *components.Splash
+	mainMenuList         *components.List // Main menu with resource type selection
+	stacksList           *components.List
+	stackResourcesList   *components.List
+	stackTreeList        *components.List
+	clustersList         *components.List // ECS clusters list
+	serviceList          *components.List
+	lambdaList           *components.List
+	apiGatewayList       *components.List
+	apiStagesList        *components.List
+	ec2List              *components.List                 // For jump host selection
+	containerList        *components.List                 // For container selection in port forwarding
 	sqsTable             *components.SQSTable             // For SQS queues table view
 	sqsDetails           *components.SQSDetails           // For SQS queue details view
 	dynamodbTable        *components.DynamoDBTable        // For DynamoDB tables view
 	dynamodbQueryDialog  *components.DynamoDBQueryDialog  // For DynamoDB query input
 	dynamodbQueryResults *components.DynamoDBQueryResults // For DynamoDB query results
 	details              *components.Details
-	logs                *components.Logs
-	tunnelsPanel        *components.TunnelsPanel
-	cloudWatchLogsPanel *components.CloudWatchLogsPanel
-	profileSelector     *components.ProfileSelector
-	commandPalette      *components.CommandPalette
-	refreshIndicator    *components.RefreshIndicator
+	logs                 *components.Logs
+	tunnelsPanel         *components.TunnelsPanel
+	cloudWatchLogsPanel  *components.CloudWatchLogsPanel
+	profileSelector      *components.ProfileSelector
+	commandPalette       *components.CommandPalette
+	refreshIndicator     *components.RefreshIndicator
+	lastAutoRefresh      map[string]time.Time // Per-resource last auto-refresh times
 
 	// Phase 1 UI components
 	statusBar      *components.StatusBar
@@ -90,6 +97,14 @@ type Model struct {
 	detailsSearchInput textinput.Model
 	detailsSearching   bool
 
+	// Global resource search
+	globalSearchInput      textinput.Model
+	globalSearching        bool
+	globalSearchQuery      string
+	globalSearchResults    []globalSearchResult
+	globalSearchList       *components.List
+	viewBeforeGlobalSearch state.View
+
 	// Port forward input
 	portInput          textinput.Model
 	enteringPort       bool
@@ -100,6 +115,8 @@ type Model struct {
 	payloadInput          textinput.Model
 	enteringPayload       bool
 	pendingInvokeFunction *model.Function
+	invokeAsync           bool   // Event (async) mode, remembered across invokes
+	preflightWarning      string // Set when the permission preflight predicts a denial
 
 	// API Gateway port forward
 	pendingAPIGWPortForward *model.APIStage
@@ -113,8 +130,8 @@ type Model struct {
 	height int
 
 	// Status
-	ready      bool
-	showSplash bool
+	ready          bool
+	showSplash     bool
 	copyMode       bool // Copy mode for clean text selection
 	copyModeScroll int  // Scroll offset for copy mode content
 
@@ -125,10 +142,237 @@ type Model struct {
 	// Track view before region selection to return to it
 	viewBeforeRegionSelect state.View
 
-	// Lazy loading channels
+	// Lazy loading channels and the stream generation used to drop messages
+	// from streams that predate a profile/region switch
+	loadGeneration      int
 	functionsResultChan chan functionsLoadedMsg
 	queuesResultChan    chan queuesLoadedMsg
 	tablesResultChan    chan tablesLoadedMsg
+
+	// Cancel funcs for the lazy loads above, so Esc can stop background
+	// pagination mid-stream
+	functionsLoadCancel context.CancelFunc
+	queuesLoadCancel    context.CancelFunc
+	tablesLoadCancel    context.CancelFunc
+
+	// CloudWatch fetch tuning (reduced-limit retry after timeouts)
+	cloudWatchFetchLimit    int32
+	cloudWatchFetchTimedOut bool
+
+	// Recently viewed resources (session-scoped MRU)
+	recentResources []globalSearchResult
+
+	// Region latency measurements, cached for the session
+	regionLatencies map[string]time.Duration
+
+	// CloudWatch alarms per resource key ("sqs:<name>", "lambda:<name>")
+	resourceAlarms map[string][]model.Alarm
+
+	// Exact DynamoDB item counts from on-demand COUNT scans
+	exactCounts map[string]int64
+
+	// Queue depth history for sparklines, cached briefly per queue
+	queueMetrics map[string]queueMetricsEntry
+
+	// Per-stack resource counts for list badges, cached for the session
+	stackCounts     map[string]aws.StackResourceCounts
+	stackCountsChan chan stackCountsMsg
+	fnTagsChan      chan fnTagsMsg
+
+	// Large-scan confirmation
+	scanConfirmActive bool
+	pendingScanParams *model.ScanParams
+
+	// Invocation output routing
+	outputRouteActive bool
+	outputRouteCursor int
+	lastOutputRoute   string
+
+	// Idle-timeout tracking
+	lastInputAt time.Time
+	idleLocked  bool
+	idleWarned  bool
+
+	// Task-level overview list
+	tasksList *components.List
+
+	// Service event logs fetched on demand
+	svcEvents map[string][]model.ServiceEvent
+
+	// Task definitions fetched on demand per service
+	svcTaskDefs map[string]*model.TaskDefinition
+
+	// Active PartiQL statement driving the results view (empty when the
+	// builder-based query/scan flow owns the results)
+	partiqlStatement string
+
+	// Original wire JSON of the item being edited, for change detection
+	itemEditOriginal string
+
+	// Item delete confirmation
+	itemDeleteConfirmActive bool
+	itemDeleteTarget        string // wire JSON of the item being deleted
+
+	// API routes list (v2 APIs, including WebSocket route keys)
+	apiRoutesList *components.List
+
+	// EC2 lifecycle action picker and state polling
+	ec2ActionActive bool
+	ec2ActionCursor int
+	ec2ActionTarget *model.EC2Instance
+	ec2States       map[string]string // transitional state overrides per instance
+	ec2PollInstance string
+	ec2PollCount    int
+
+	// Stage variable editor
+	stageVarActive bool
+	stageVarStage  string
+	stageVarInput  textinput.Model
+
+	// Tunnel HTTP request builder
+	tunnelReqStage   int
+	tunnelReqPort    int
+	tunnelReqMethod  string
+	tunnelReqPath    string
+	tunnelReqHeaders string
+	tunnelReqInput   textinput.Model
+
+	// Put-item flow (item JSON pending the overwrite-guard prompt)
+	putItemJSON        string
+	putItemGuardActive bool
+
+	// Query index picker
+	indexPickActive  bool
+	indexPickCursor  int
+	indexPickOptions []indexOption
+	pendingIndexName string // IndexName applied to the next query from the dialog
+
+	// Force-deployment confirmation
+	forceDeployConfirmActive bool
+	forceDeployService       *model.Service
+
+	// ECS scaling-progress overlay
+	scaleWatchActive   bool
+	scaleWatchStable   bool
+	scaleWatchTimedOut bool
+	scaleWatchService  *model.Service
+	scaleWatchStarted  time.Time
+
+	// Shared structured data editor and the flows driving it
+	structuredEditor *components.StructuredEditor
+	editorPurpose    string
+	envEditFn        string
+	revealEnvVars    bool
+	sendMsgQueue     *model.Queue
+	sendMsgBody      string
+	sendMsgStage     int
+	sendMsgGroupID   string
+	sendMsgInput     textinput.Model
+
+	// Peeked message inspection list and delete confirmation
+	peekList               *components.List
+	deleteMsgConfirmActive bool
+	deleteMsgTarget        *model.Message
+
+	// Purge confirmation
+	purgeConfirmActive bool
+	purgeQueue         *model.Queue
+
+	// DLQ redrive dialog and tracking
+	redriveActive       bool
+	redriveRateInput    textinput.Model
+	activeRedriveHandle string
+	activeRedriveSource string
+	redrivePolling      bool
+
+	// Combined profile/region switcher overlay
+	ctxSwitchActive   bool
+	ctxSwitchStage    int
+	ctxSwitchProfiles []string
+	ctxSwitchRegions  []components.Region
+	ctxSwitchCursor   int
+	ctxSwitchProfile  string
+	ctxSwitchRegion   string
+	ctxSwitchAccount  string
+	ctxSwitchClient   *aws.Client
+	ctxSwitchErr      error
+	ctxSwitchLoading  bool
+
+	// SSO re-auth modal after a token-expired load failure
+	ssoLoginActive bool
+
+	// Full help modal state
+	helpActive bool
+	helpScroll int
+
+	// Double-click detection for list selection (opt-in mouse clicks)
+	lastClickAt time.Time
+	lastClickY  int
+
+	// Shared tag editor target and session cache of fetched tags
+	tagEditKind     string
+	tagEditID       string
+	tagEditName     string
+	tagEditOriginal map[string]string
+	resourceTags    map[string]map[string]string
+
+	// Session cache of fetched function URL configs (nil = no URL)
+	fnURLs map[string]*model.FunctionUrl
+
+	// Full resource listing of the selected stack (tree view)
+	stackTree []model.StackResource
+
+	// Session caches of fetched stack events, drift results and full
+	// descriptions (outputs/parameters)
+	stackEvents map[string][]model.StackEvent
+	stackDrift  map[string]string
+	stackInfo   map[string]*model.Stack
+
+	// Logs Insights query context
+	insightsLogGroup string
+	insightsCancel   context.CancelFunc
+
+	// Client-side CloudWatch log filter input
+	cwFilterInput textinput.Model
+	cwFiltering   bool
+
+	// Server-side CloudWatch filter pattern and its input
+	cwServerPattern  string
+	cwPatternInput   textinput.Model
+	cwPatternEditing bool
+
+	// Event source mappings per function, fetched on demand
+	fnMappings map[string][]model.EventSourceMapping
+
+	// Event source mapping toggle prompt and settle polling
+	esmToggleActive bool
+	esmToggleFn     string
+	esmInput        textinput.Model
+	esmPollFn       string
+	esmPollUUID     string
+	esmPollCount    int
+
+	// Lambda aliases and versions sub-view
+	aliasList       *components.List
+	aliasFn         string
+	fnAliases       []model.LambdaAlias
+	fnVersions      []model.LambdaVersion
+	invokeQualifier string
+
+	// Lambda memory/timeout edit form
+	lambdaEditStage  int
+	lambdaEditFn     *model.Function
+	lambdaEditMemory int
+	lambdaEditInput  textinput.Model
+
+	// DynamoDB query templates
+	templatesList         *components.List
+	queryTemplates        []config.QueryTemplate
+	pendingTemplate       *config.QueryTemplate
+	templateParamInput    textinput.Model
+	enteringTemplateParam bool
+	templateParams        map[string]string
+	templateParamQueue    []string
 }
 
 // New creates a new Model.
@@ -151,33 +395,86 @@ func New(client *aws.Client, logger *log.Logger, version string) *Model {
 	detailsSearchInput.Placeholder = "Search..."
 	detailsSearchInput.CharLimit = 64
 
+	globalSearchInput := textinput.New()
+	globalSearchInput.Placeholder = "Search all resources..."
+	globalSearchInput.CharLimit = 64
+	globalSearchInput.Width = 50
+
+	templateParamInput := textinput.New()
+	templateParamInput.CharLimit = 256
+	templateParamInput.Width = 40
+
+	redriveRateInput := textinput.New()
+	redriveRateInput.Placeholder = "unlimited"
+	redriveRateInput.CharLimit = 4
+	redriveRateInput.Width = 12
+
+	sendMsgInput := textinput.New()
+	sendMsgInput.CharLimit = 128
+	sendMsgInput.Width = 40
+
+	lambdaEditInput := textinput.New()
+	lambdaEditInput.CharLimit = 5
+	lambdaEditInput.Width = 10
+
+	esmInput := textinput.New()
+	esmInput.CharLimit = 2
+	esmInput.Width = 6
+
+	cwFilterInput := textinput.New()
+	cwFilterInput.Placeholder = "Filter logs (leading / for regexp)..."
+	cwFilterInput.CharLimit = 128
+	cwFilterInput.Width = 40
+
+	cwPatternInput := textinput.New()
+	cwPatternInput.Placeholder = `CloudWatch pattern, e.g. "ERROR" - "healthcheck"`
+	cwPatternInput.CharLimit = 256
+	cwPatternInput.Width = 40
+
+	tunnelReqInput := textinput.New()
+	tunnelReqInput.CharLimit = 512
+	tunnelReqInput.Width = 44
+
+	stageVarInput := textinput.New()
+	stageVarInput.Placeholder = "endpoint=https://..."
+	stageVarInput.CharLimit = 256
+	stageVarInput.Width = 40
+
 	// Load configuration
 	cfg, _ := config.Load()
 
 	statusBar := components.NewStatusBar()
 	statusBar.SetVersion(version)
+	statusBar.SetEndpointOverride(aws.EndpointOverride())
 	quickBar := components.NewQuickBar()
 
 	m := &Model{
-		client:              client,
-		logger:              logger,
-		tunnelManager:       tunnel.NewManager(client.Profile(), client.Region()),
-		apiGWManager:        tunnel.NewAPIGatewayManager(client.Profile(), client.Region()),
-		cfg:                 cfg,
-		state:               state.New(),
-		splash:              components.NewSplash(version),
-		mainMenuList:        components.NewList("AWS Resources"),
-		stacksList:          components.NewList("CloudFormation Stacks"),
-		stackResourcesList:  components.NewList("Stack Resources"),
-		clustersList:        components.NewList("ECS Clusters"),
-		serviceList:         components.NewList("ECS Services"),
-		lambdaList:          components.NewList("Lambda Functions"),
-		apiGatewayList:      components.NewList("API Gateway"),
-		apiStagesList:       components.NewList("API Stages"),
-		ec2List:             components.NewList("Select Jump Host"),
-		containerList:       components.NewList("Select Container"),
-		sqsTable:            components.NewSQSTable(),
-		sqsDetails:          components.NewSQSDetails(),
+		client:               client,
+		logger:               logger,
+		tunnelManager:        tunnel.NewManager(client.Profile(), client.Region()),
+		apiGWManager:         tunnel.NewAPIGatewayManager(client.Profile(), client.Region()),
+		cfg:                  cfg,
+		state:                state.New(),
+		splash:               components.NewSplash(version),
+		mainMenuList:         components.NewList("AWS Resources"),
+		stacksList:           components.NewList("CloudFormation Stacks"),
+		stackResourcesList:   components.NewList("Stack Resources"),
+		stackTreeList:        components.NewList("Stack Resources (all)"),
+		clustersList:         components.NewList("ECS Clusters"),
+		serviceList:          components.NewList("ECS Services"),
+		lambdaList:           components.NewList("Lambda Functions"),
+		apiGatewayList:       components.NewList("API Gateway"),
+		apiStagesList:        components.NewList("API Stages"),
+		ec2List:              components.NewList("Select Jump Host"),
+		containerList:        components.NewList("Select Container"),
+		globalSearchList:     components.NewList("Search Results"),
+		tasksList:            components.NewList("ECS Tasks"),
+		apiRoutesList:        components.NewList("API Routes"),
+		templatesList:        components.NewList("Query Templates"),
+		peekList:             components.NewList("Peeked Messages"),
+		aliasList:            components.NewList("Aliases & Versions"),
+		sqsTable:             components.NewSQSTable(),
+		sqsDetails:           components.NewSQSDetails(),
 		dynamodbTable:        components.NewDynamoDBTable(),
 		dynamodbQueryDialog:  components.NewDynamoDBQueryDialog(),
 		dynamodbQueryResults: components.NewDynamoDBQueryResults(),
@@ -195,12 +492,30 @@ func New(client *aws.Client, logger *log.Logger, version string) *Model {
 		portInput:            portInput,
 		payloadInput:         payloadInput,
 		detailsSearchInput:   detailsSearchInput,
+		globalSearchInput:    globalSearchInput,
+		templateParamInput:   templateParamInput,
+		redriveRateInput:     redriveRateInput,
+		structuredEditor:     components.NewStructuredEditor(),
+		sendMsgInput:         sendMsgInput,
+		lambdaEditInput:      lambdaEditInput,
+		esmInput:             esmInput,
+		cwFilterInput:        cwFilterInput,
+		cwPatternInput:       cwPatternInput,
+		tunnelReqInput:       tunnelReqInput,
+		stageVarInput:        stageVarInput,
 		keys:                 DefaultKeyMap(),
 		showSplash:           true,
 	}
 
 	m.state.Profile = client.Profile()
 	m.state.Region = client.Region()
+	m.installDefaultFilters()
+	m.applyTunnelConfig()
+	m.applyAutoRefreshConfig()
+	m.applyKeymapConfig()
+	if cfg != nil {
+		m.regionSelector.SetCustomRegions(cfg.CustomRegions)
+	}
 
 	return m
 }
@@ -225,6 +540,51 @@ func NewWithProfileSelection(profiles []string, region string, logger *log.Logge
 	detailsSearchInput.Placeholder = "Search..."
 	detailsSearchInput.CharLimit = 64
 
+	globalSearchInput := textinput.New()
+	globalSearchInput.Placeholder = "Search all resources..."
+	globalSearchInput.CharLimit = 64
+	globalSearchInput.Width = 50
+
+	templateParamInput := textinput.New()
+	templateParamInput.CharLimit = 256
+	templateParamInput.Width = 40
+
+	redriveRateInput := textinput.New()
+	redriveRateInput.Placeholder = "unlimited"
+	redriveRateInput.CharLimit = 4
+	redriveRateInput.Width = 12
+
+	sendMsgInput := textinput.New()
+	sendMsgInput.CharLimit = 128
+	sendMsgInput.Width = 40
+
+	lambdaEditInput := textinput.New()
+	lambdaEditInput.CharLimit = 5
+	lambdaEditInput.Width = 10
+
+	esmInput := textinput.New()
+	esmInput.CharLimit = 2
+	esmInput.Width = 6
+
+	cwFilterInput := textinput.New()
+	cwFilterInput.Placeholder = "Filter logs (leading / for regexp)..."
+	cwFilterInput.CharLimit = 128
+	cwFilterInput.Width = 40
+
+	cwPatternInput := textinput.New()
+	cwPatternInput.Placeholder = `CloudWatch pattern, e.g. "ERROR" - "healthcheck"`
+	cwPatternInput.CharLimit = 256
+	cwPatternInput.Width = 40
+
+	tunnelReqInput := textinput.New()
+	tunnelReqInput.CharLimit = 512
+	tunnelReqInput.Width = 44
+
+	stageVarInput := textinput.New()
+	stageVarInput.Placeholder = "endpoint=https://..."
+	stageVarInput.CharLimit = 256
+	stageVarInput.Width = 40
+
 	profileSelector := components.NewProfileSelector()
 	profileSelector.SetProfiles(profiles)
 
@@ -233,26 +593,34 @@ func NewWithProfileSelection(profiles []string, region string, logger *log.Logge
 
 	statusBar := components.NewStatusBar()
 	statusBar.SetVersion(version)
+	statusBar.SetEndpointOverride(aws.EndpointOverride())
 	quickBar := components.NewQuickBar()
 
 	m := &Model{
-		client:              nil, // Will be created after profile selection
-		logger:              logger,
-		tunnelManager:       nil, // Will be created after profile selection
-		apiGWManager:        nil, // Will be created after profile selection
-		cfg:                 cfg,
-		state:               state.New(),
-		splash:              components.NewSplash(version),
-		mainMenuList:        components.NewList("AWS Resources"),
-		stacksList:          components.NewList("CloudFormation Stacks"),
-		stackResourcesList:  components.NewList("Stack Resources"),
-		clustersList:        components.NewList("ECS Clusters"),
-		serviceList:         components.NewList("ECS Services"),
-		lambdaList:          components.NewList("Lambda Functions"),
-		apiGatewayList:      components.NewList("API Gateway"),
-		apiStagesList:       components.NewList("API Stages"),
-		ec2List:             components.NewList("Select Jump Host"),
-		containerList:       components.NewList("Select Container"),
+		client:               nil, // Will be created after profile selection
+		logger:               logger,
+		tunnelManager:        nil, // Will be created after profile selection
+		apiGWManager:         nil, // Will be created after profile selection
+		cfg:                  cfg,
+		state:                state.New(),
+		splash:               components.NewSplash(version),
+		mainMenuList:         components.NewList("AWS Resources"),
+		stacksList:           components.NewList("CloudFormation Stacks"),
+		stackResourcesList:   components.NewList("Stack Resources"),
+		stackTreeList:        components.NewList("Stack Resources (all)"),
+		clustersList:         components.NewList("ECS Clusters"),
+		serviceList:          components.NewList("ECS Services"),
+		lambdaList:           components.NewList("Lambda Functions"),
+		apiGatewayList:       components.NewList("API Gateway"),
+		apiStagesList:        components.NewList("API Stages"),
+		ec2List:              components.NewList("Select Jump Host"),
+		containerList:        components.NewList("Select Container"),
+		globalSearchList:     components.NewList("Search Results"),
+		tasksList:            components.NewList("ECS Tasks"),
+		apiRoutesList:        components.NewList("API Routes"),
+		templatesList:        components.NewList("Query Templates"),
+		peekList:             components.NewList("Peeked Messages"),
+		aliasList:            components.NewList("Aliases & Versions"),
 		sqsTable:             components.NewSQSTable(),
 		sqsDetails:           components.NewSQSDetails(),
 		dynamodbTable:        components.NewDynamoDBTable(),
@@ -264,39 +632,67 @@ func NewWithProfileSelection(profiles []string, region string, logger *log.Logge
 		cloudWatchLogsPanel:  components.NewCloudWatchLogsPanel(),
 		profileSelector:      profileSelector,
 		commandPalette:       components.NewCommandPalette(),
-		refreshIndicator:    components.NewRefreshIndicator(),
-		statusBar:           statusBar,
-		container:           components.NewContainer(),
-		quickBar:            quickBar,
-		regionSelector:      components.NewRegionSelector(),
+		refreshIndicator:     components.NewRefreshIndicator(),
+		statusBar:            statusBar,
+		container:            components.NewContainer(),
+		quickBar:             quickBar,
+		regionSelector:       components.NewRegionSelector(),
 		filterInput:          ti,
 		portInput:            portInput,
 		payloadInput:         payloadInput,
 		detailsSearchInput:   detailsSearchInput,
+		globalSearchInput:    globalSearchInput,
+		templateParamInput:   templateParamInput,
+		redriveRateInput:     redriveRateInput,
+		structuredEditor:     components.NewStructuredEditor(),
+		sendMsgInput:         sendMsgInput,
+		lambdaEditInput:      lambdaEditInput,
+		esmInput:             esmInput,
+		cwFilterInput:        cwFilterInput,
+		cwPatternInput:       cwPatternInput,
+		tunnelReqInput:       tunnelReqInput,
+		stageVarInput:        stageVarInput,
 		keys:                 DefaultKeyMap(),
-		showSplash:          false, // Skip splash, go straight to profile selection
-		pendingRegion:       region,
+		showSplash:           false, // Skip splash, go straight to profile selection
+		pendingRegion:        region,
 	}
 
 	m.state.View = state.ViewProfileSelect
 	m.state.Profiles = profiles
+	m.installDefaultFilters()
+	m.applyAutoRefreshConfig()
+	m.applyKeymapConfig()
+	if cfg != nil {
+		m.regionSelector.SetCustomRegions(cfg.CustomRegions)
+	}
 
 	return m
 }
 
 // Init implements tea.Model.
 func (m *Model) Init() tea.Cmd {
+	m.lastInputAt = time.Now()
+
 	// If in profile selection mode, don't load anything yet
 	if m.state.View == state.ViewProfileSelect {
-		return tea.EnableMouseCellMotion
+		return tea.Batch(tea.EnableMouseCellMotion, m.idleTick(), m.clockTick())
 	}
 	// Start at main menu - don't load stacks automatically
 	// User will select what to load from the main menu
 	m.updateMainMenuList()
+
+	// Offer to re-establish tunnels that died with the previous session
+	if m.tunnelManager != nil {
+		if n := len(m.tunnelManager.RestorableTunnels()); n > 0 {
+			m.logger.Info("%d tunnel(s) from the previous session can be restored - press B in the tunnels view", n)
+		}
+	}
 	return tea.Batch(
 		tea.EnableMouseCellMotion,    // Enable mouse for scroll wheel
 		m.splash.TickCmd(),           // Start splash animation
 		m.refreshIndicator.TickCmd(), // Start auto-refresh timer
+		m.idleTick(),                 // Start idle-timeout checks (if enabled)
+		m.clockTick(),                // Start the status bar clock
 	)
 }
 
@@ -306,18 +702,32 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	switch msg := msg.(type) {
 	case tea.MouseMsg:
-		// Handle mouse wheel scrolling
+		// Handle mouse wheel scrolling and (opt-in) row clicks
 		if msg.Action == tea.MouseActionPress {
 			switch msg.Button {
 			case tea.MouseButtonWheelUp:
 				m.handleMouseWheelUp(msg.X)
 			case tea.MouseButtonWheelDown:
 				m.handleMouseWheelDown(msg.X)
+			case tea.MouseButtonLeft:
+				if cmd := m.handleMouseClick(msg.X, msg.Y); cmd != nil {
+					return m, cmd
+				}
 			}
 		}
 		return m, nil
 
 	case tea.KeyMsg:
+		// Idle tracking: any key resets the timer, and a locked UI swallows
+		// the key that wakes it
+		m.lastInputAt = time.Now()
+		m.idleWarned = false
+		if m.idleLocked {
+			m.idleLocked = false
+			m.logger.Info("UI unlocked")
+			return m, nil
+		}
+
 		// Handle profile selection view
 		if m.state.View == state.ViewProfileSelect {
 			return m.handleProfileSelectKey(msg)
@@ -374,7 +784,11 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case clientCreatedMsg:
 		m.awaitingClientCreate = false
 		if msg.err != nil {
-			m.logger.Error("Failed to create AWS client: %v", msg.err)
+			if aws.IsSSOTokenExpired(msg.err) {
+				m.logger.Error("SSO session expired - run: aws sso login --profile <profile> and retry")
+			} else {
+				m.logger.Error("Failed to create AWS client: %v", msg.err)
+			}
 			// Show error and go back to profile selection
 			m.state.View = state.ViewProfileSelect
 			return m, nil
@@ -382,6 +796,7 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// AWS client created successfully
 		m.client = msg.client
 		m.tunnelManager = tunnel.NewManager(msg.client.Profile(), msg.client.Region())
+		m.applyTunnelConfig()
 		m.apiGWManager = tunnel.NewAPIGatewayManager(msg.client.Profile(), msg.client.Region())
 		m.state.Profile = msg.client.Profile()
 		m.state.Region = msg.client.Region()
@@ -390,12 +805,19 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.splash.SetLoading("Connected to " + msg.client.Region())
 		m.updateComponentSizes()
 		m.updateMainMenuList()
+		if n := len(m.tunnelManager.RestorableTunnels()); n > 0 {
+			m.logger.Info("%d tunnel(s) from the previous session can be restored - press B in the tunnels view", n)
+		}
 		// Show main menu - don't load stacks automatically
 		return m, m.splash.TickCmd()
 
 	case regionChangedMsg:
 		if msg.err != nil {
-			m.logger.Error("Failed to switch region: %v", msg.err)
+			if aws.IsSSOTokenExpired(msg.err) {
+				m.logger.Error("SSO session expired - run: aws sso login --profile %s and retry", m.state.Profile)
+			} else {
+				m.logger.Error("Failed to switch region: %v", msg.err)
+			}
 			m.state.View = m.viewBeforeRegionSelect
 			return m, nil
 		}
@@ -403,17 +825,26 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.client = msg.client
 		m.state.Region = msg.region
 		m.tunnelManager = tunnel.NewManager(m.state.Profile, msg.region)
+		m.applyTunnelConfig()
 		m.apiGWManager = tunnel.NewAPIGatewayManager(m.state.Profile, msg.region)
 
 		// Clear all cached data
-		m.state.ClearStacks()
-		m.state.ClearServices()
-		m.state.ClearQueues()
-		m.state.ClearTables()
-		m.state.ClearFunctions()
-		m.state.ClearAPIs()
-		m.state.Clusters = nil
-		m.state.ClustersError = nil
+		m.cancelLazyLoads()
+		m.state.ClearAllData()
+		m.stackCounts = nil
+		m.resourceAlarms = nil
+		m.exactCounts = nil
+		m.queueMetrics = nil
+		m.fnMappings = nil
+		m.svcEvents = nil
+		m.svcTaskDefs = nil
+		m.resourceTags = nil
+		m.fnURLs = nil
+		m.stackTree = nil
+		m.stackEvents = nil
+		m.stackDrift = nil
+		m.stackInfo = nil
+		m.loadGeneration++
 
 		m.logger.Info("Switched to region: %s", msg.region)
 
@@ -460,23 +891,25 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			cmds = append(cmds, m.stacksList.Spinner().TickCmd())
 		}
 
+	case clockTickMsg:
+		m.statusBar.SetClock(time.Time(msg))
+		return m, m.clockTick()
+
 	case components.AutoRefreshTickMsg:
 		// Auto-refresh current view data
 		if m.state.AutoRefresh && !m.showSplash && m.client != nil {
 			m.refreshIndicator.Tick()
-			m.refreshIndicator.SetRefreshing(true)
 
-			// Refresh based on current view
-			var refreshCmd tea.Cmd
-			switch m.state.View {
-			case state.ViewStacks:
-				refreshCmd = m.loadStacks()
-			case state.ViewServices:
-				refreshCmd = m.loadServices()
+			// Re-run the view's loader when its configured interval elapsed
+			if refreshCmd := m.autoRefreshTick(); refreshCmd != nil {
+				m.refreshIndicator.SetRefreshing(true)
+				cmds = append(cmds, refreshCmd)
 			}
 
-			if refreshCmd != nil {
-				cmds = append(cmds, refreshCmd)
+			if m.state.View == state.ViewTunnels {
+				// Tunnel status changes happen in background goroutines
+				// (monitoring, auto-reconnect); repaint the panel each tick
+				m.updateTunnelsPanel()
 			}
 
 			// Schedule next refresh
@@ -489,8 +922,11 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if msg.err != nil {
 			m.state.StacksError = msg.err
 			m.logger.Error("Failed to load stacks: %v", msg.err)
+			m.maybeOfferSSOLogin(msg.err)
 			m.splash.SetLoading("Error loading stacks")
 		} else {
+			firstLoad := m.state.Stacks == nil
+			m.state.DiffStacks(msg.stacks)
 			m.state.Stacks = msg.stacks
 			m.state.StacksError = nil
 			m.logger.Info("Loaded %d CloudFormation stacks", len(msg.stacks))
@@ -499,6 +935,19 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if m.showSplash {
 				m.showSplash = false
 			}
+			// Auto-select when the first load returns exactly one stack
+			if firstLoad && m.autoSelectSingle() && len(msg.stacks) == 1 && m.state.View == state.ViewStacks {
+				m.logger.Info("Auto-selecting single stack: %s", msg.stacks[0].Name)
+				m.state.SelectStack(&m.state.Stacks[0])
+				m.updateStackResourcesList()
+				return m, nil
+			}
+			// Kick off lazy resource-count badges when enabled
+			if m.cfg != nil && m.cfg.Defaults.StackBadges {
+				if cmd := m.loadStackCounts(msg.stacks); cmd != nil {
+					cmds = append(cmds, cmd)
+				}
+			}
 		}
 		m.updateStacksList()
 
@@ -508,19 +957,25 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if msg.err != nil {
 			m.state.ServicesError = msg.err
 			m.logger.Error("Failed to load services: %v", msg.err)
+			m.maybeOfferSSOLogin(msg.err)
 		} else {
+			m.state.DiffServices(msg.services)
 			m.state.Services = msg.services
 			m.state.ServicesError = nil
 		}
 		m.updateServicesList()
 
 	case functionsLoadedMsg:
+		if msg.gen != m.loadGeneration {
+			return m, nil // stale stream from before a profile/region switch
+		}
 		if msg.err != nil {
 			m.state.FunctionsLoading = false
 			m.state.FunctionsError = msg.err
 			m.refreshIndicator.SetRefreshing(false)
 			m.lambdaList.SetLoading(false)
 			m.logger.Error("Failed to load Lambda functions: %v", msg.err)
+			m.maybeOfferSSOLogin(msg.err)
 		} else {
 			// Handle incremental loading
 			if msg.isAppend {
@@ -609,6 +1064,11 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		task := msg.tasks[0]
 
+		// Preflight the tunnel target (SSM status / platform version)
+		if cmd := m.checkTunnelTarget(msg.service, task); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+
 		// Get containers with RuntimeID
 		var containersWithRuntime []model.Container
 		for _, c := range task.Containers {
@@ -658,6 +1118,11 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		task := msg.tasks[0]
 
+		// Preflight the tunnel target (SSM status / platform version)
+		if cmd := m.checkTunnelTarget(msg.service, task); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+
 		// Get containers with RuntimeID
 		var containersWithRuntime []model.Container
 		for _, c := range task.Containers {
@@ -795,6 +1260,25 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Start the private API Gateway tunnel
 		return m, m.startPrivateAPIGWTunnel(msg.api, msg.stage, msg.jumpHost, msg.vpcEndpoint, msg.localPort)
 
+	case editorFinishedMsg:
+		os.Remove(msg.path)
+		if msg.err != nil {
+			m.logger.Warn("Editor exited with error: %v", msg.err)
+		}
+
+	case tunnelTargetCheckedMsg:
+		if msg.err != nil {
+			m.logger.Warn("Could not verify container instance SSM status: %v", msg.err)
+			return m, nil
+		}
+		if msg.managed {
+			m.logger.Info("Container instance %s is SSM-managed", msg.instanceID)
+		} else {
+			m.logger.Error("Container instance %s is NOT SSM-managed - the tunnel will fail. Check the SSM agent and instance profile.", msg.instanceID)
+			m.state.ShowLogs = true
+			m.updateComponentSizes()
+		}
+
 	case tunnelRefreshMsg:
 		m.updateTunnelsPanel()
 
@@ -813,6 +1297,8 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		m.cloudWatchFetchLimit = 0
+		m.cloudWatchFetchTimedOut = false
 		m.state.CloudWatchLogConfigs = msg.configs
 		m.state.CloudWatchServiceContext = &msg.service
 		m.state.CloudWatchTaskContext = &msg.task
@@ -834,10 +1320,19 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case cloudWatchLogsLoadedMsg:
 		if msg.err != nil {
-			m.logger.Error("Failed to fetch CloudWatch logs: %v", msg.err)
+			// A timed-out fetch must not advance CloudWatchLastFetchTime, so
+			// the retry below starts from the same place.
+			if errors.Is(msg.err, context.DeadlineExceeded) {
+				m.cloudWatchFetchTimedOut = true
+				m.logger.Warn("CloudWatch fetch timed out - try a narrower time range or smaller limit (press R to retry with limit %d)",
+					reducedLogFetchLimit(m.currentLogFetchLimit()))
+			} else {
+				m.logger.Error("Failed to fetch CloudWatch logs: %v", msg.err)
+			}
 			return m, nil
 		}
 
+		m.cloudWatchFetchTimedOut = false
 		m.state.CloudWatchLastFetchTime = msg.lastTimestamp
 
 		if len(m.state.CloudWatchLogs) == 0 {
@@ -874,18 +1369,23 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 	case queuesLoadedMsg:
+		if msg.gen != m.loadGeneration {
+			return m, nil // stale stream from before a profile/region switch
+		}
 		if msg.err != nil {
 			m.state.QueuesLoading = false
 			m.state.QueuesError = msg.err
 			m.refreshIndicator.SetRefreshing(false)
 			m.sqsTable.SetLoading(false)
 			m.logger.Error("Failed to load SQS queues: %v", msg.err)
+			m.maybeOfferSSOLogin(msg.err)
 		} else {
 			// Handle incremental loading
 			if msg.isAppend {
 				m.state.Queues = append(m.state.Queues, msg.queues...)
 				m.logger.Debug("Loaded %d more SQS queues (total: %d)", len(msg.queues), len(m.state.Queues))
 			} else {
+				m.state.DiffQueues(msg.queues)
 				m.state.Queues = msg.queues
 				m.logger.Info("Loaded %d SQS queues", len(msg.queues))
 			}
@@ -912,20 +1412,32 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if msg.err != nil {
 			m.state.ClustersError = msg.err
 			m.logger.Error("Failed to load ECS clusters: %v", msg.err)
+			m.maybeOfferSSOLogin(msg.err)
 		} else {
+			firstLoad := m.state.Clusters == nil
 			m.state.Clusters = msg.clusters
 			m.state.ClustersError = nil
 			m.logger.Info("Loaded %d ECS clusters", len(msg.clusters))
+			// Auto-select when the first load returns exactly one cluster
+			if firstLoad && m.autoSelectSingle() && len(msg.clusters) == 1 && m.state.View == state.ViewClusters {
+				m.logger.Info("Auto-selecting single cluster: %s", msg.clusters[0].Name)
+				m.state.SelectCluster(&m.state.Clusters[0])
+				return m, m.loadServicesForCluster()
+			}
 		}
 		m.updateClustersList()
 
 	case tablesLoadedMsg:
+		if msg.gen != m.loadGeneration {
+			return m, nil // stale stream from before a profile/region switch
+		}
 		if msg.err != nil {
 			m.state.TablesLoading = false
 			m.state.TablesError = msg.err
 			m.refreshIndicator.SetRefreshing(false)
 			m.dynamodbTable.SetLoading(false)
 			m.logger.Error("Failed to load DynamoDB tables: %v", msg.err)
+			m.maybeOfferSSOLogin(msg.err)
 		} else {
 			// Handle incremental loading
 			if msg.isAppend {
@@ -975,6 +1487,485 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				msg.result.Count, msg.result.ScannedCount, msg.result.ConsumedCapacity)
 		}
 
+	case idleTickMsg:
+		return m.handleIdleTick()
+
+	case ec2ActionMsg:
+		if msg.err != nil {
+			m.logger.Error("Failed to %s %s: %v", msg.action, msg.instanceID, msg.err)
+			m.state.ShowLogs = true
+			m.updateComponentSizes()
+			return m, nil
+		}
+		m.logger.Info("%s accepted for %s - watching state", msg.action, msg.instanceID)
+		m.applyEC2State(msg.instanceID, "pending")
+		return m, m.beginEC2Watch(msg.instanceID)
+
+	case ec2PollTickMsg:
+		if m.ec2PollInstance != "" {
+			return m, m.pollEC2State()
+		}
+
+	case ec2StateMsg:
+		if m.ec2PollInstance != msg.instanceID {
+			return m, nil // a newer action superseded this poll
+		}
+		if msg.err != nil {
+			m.logger.Warn("Failed to poll instance state: %v", msg.err)
+			m.ec2PollInstance = ""
+			return m, nil
+		}
+		m.applyEC2State(msg.instanceID, msg.state)
+		switch msg.state {
+		case "running", "stopped", "terminated":
+			m.logger.Info("%s is now %s", msg.instanceID, msg.state)
+			m.ec2PollInstance = ""
+		default:
+			m.ec2PollCount++
+			if m.ec2PollCount >= ec2MaxPolls {
+				m.logger.Warn("Gave up waiting for %s to settle (still %s)", msg.instanceID, msg.state)
+				m.ec2PollInstance = ""
+				return m, nil
+			}
+			return m, m.ec2PollTick()
+		}
+
+	case apiRoutesLoadedMsg:
+		m.state.APIRoutesLoading = false
+		if msg.err != nil {
+			m.state.APIRoutesError = msg.err
+			m.logger.Error("Failed to load routes: %v", msg.err)
+		} else {
+			m.state.APIRoutes = msg.routes
+			m.state.APIRoutesError = nil
+		}
+		m.updateAPIRoutesList()
+
+	case stageVarUpdatedMsg:
+		return m, m.handleStageVarUpdated(msg)
+
+	case apiExportedMsg:
+		m.handleAPIExported(msg)
+
+	case tunnelReqMsg:
+		m.handleTunnelReq(msg)
+
+	case itemPutMsg:
+		m.handleItemPut(msg)
+
+	case itemDeletedFromTableMsg:
+		m.handleItemDeletedFromTable(msg)
+
+	case itemUpdatedMsg:
+		m.handleItemUpdated(msg)
+
+	case partiqlResultMsg:
+		return m, m.handlePartiQLResult(msg)
+
+	case taskDefMsg:
+		m.handleTaskDef(msg)
+
+	case taskListMsg:
+		m.handleTaskList(msg)
+
+	case serviceEventsMsg:
+		m.handleServiceEvents(msg)
+
+	case execTasksLoadedMsg:
+		return m, m.handleExecTasksLoaded(msg)
+
+	case execShellFinishedMsg:
+		m.handleExecShellFinished(msg)
+
+	case forceDeployMsg:
+		return m, m.handleForceDeploy(msg)
+
+	case scaleWatchTickMsg:
+		if m.scaleWatchActive && !m.scaleWatchStable && !m.scaleWatchTimedOut {
+			// Enforce the timeout here so repeated describe errors can't
+			// keep the poll loop alive forever
+			if time.Since(m.scaleWatchStarted) > scaleWatchTimeout {
+				m.scaleWatchTimedOut = true
+				m.logger.Warn("Gave up waiting for service to stabilize after %s", scaleWatchTimeout)
+				return m, nil
+			}
+			return m, m.fetchScaleWatch()
+		}
+
+	case scaleWatchUpdateMsg:
+		if !m.scaleWatchActive {
+			return m, nil // overlay closed while the poll was in flight
+		}
+		if msg.err != nil {
+			m.logger.Error("Failed to describe watched service: %v", msg.err)
+			return m, m.scaleWatchTick()
+		}
+		m.scaleWatchService = msg.service
+		switch {
+		case msg.service.IsStable():
+			m.scaleWatchStable = true
+			m.logger.Info("%s is stable: %d/%d running", msg.service.Name, msg.service.RunningCount, msg.service.DesiredCount)
+		case time.Since(m.scaleWatchStarted) > scaleWatchTimeout:
+			m.scaleWatchTimedOut = true
+			m.logger.Warn("Gave up waiting for %s to stabilize after %s", msg.service.Name, scaleWatchTimeout)
+		default:
+			return m, m.scaleWatchTick()
+		}
+
+	case messageDeletedMsg:
+		m.handleMessageDeleted(msg)
+
+	case messagesPeekedMsg:
+		m.handleMessagesPeeked(msg)
+
+	case queuePurgedMsg:
+		m.handleQueuePurged(msg)
+
+	case sqsSentMsg:
+		if msg.err != nil {
+			m.logger.Error("Failed to send message to %s: %v", msg.queueName, msg.err)
+			m.state.ShowLogs = true
+			m.updateComponentSizes()
+			return m, nil
+		}
+		m.logger.Info("Message %s sent to %s", msg.messageID, msg.queueName)
+		for i := range m.state.Queues {
+			if m.state.Queues[i].Name != msg.queueName {
+				continue
+			}
+			// Record the send for approximate-count lag feedback
+			m.state.RecordQueueSend(&m.state.Queues[i])
+			if msg.updated != nil {
+				m.state.Queues[i].ApproximateMessageCount = msg.updated.ApproximateMessageCount
+				m.state.Queues[i].ApproximateInFlight = msg.updated.ApproximateInFlight
+			}
+			break
+		}
+		m.updateQueuesList()
+
+	case redriveStartedMsg:
+		if msg.err != nil {
+			m.logger.Error("Failed to start redrive: %v", msg.err)
+			m.state.ShowLogs = true
+			m.updateComponentSizes()
+			return m, nil
+		}
+		m.activeRedriveHandle = msg.handle
+		m.activeRedriveSource = msg.sourceARN
+		m.redrivePolling = true
+		m.logger.Info("Redrive started (task %s) - watching progress", msg.handle)
+		return m, m.redrivePollTick()
+
+	case redrivePollTickMsg:
+		if m.redrivePolling && m.activeRedriveSource != "" {
+			return m, m.checkRedriveStatus()
+		}
+
+	case redriveCancelledMsg:
+		if msg.err != nil {
+			// A task that already completed can no longer be cancelled -
+			// don't present that as a failure
+			if strings.Contains(msg.err.Error(), "ResourceNotFound") {
+				m.logger.Info("Move task already finished - nothing to cancel")
+				m.activeRedriveHandle = ""
+				return m, nil
+			}
+			m.logger.Error("Failed to cancel move task: %v", msg.err)
+			return m, nil
+		}
+		m.logger.Info("Move task cancelled - approximately %d messages had already moved", msg.moved)
+		m.activeRedriveHandle = ""
+		m.redrivePolling = false
+
+	case redriveStatusMsg:
+		if msg.err != nil {
+			m.logger.Error("Failed to list move tasks: %v", msg.err)
+			m.redrivePolling = false
+			return m, nil
+		}
+
+		// While auto-polling, report just the watched task's progress and
+		// stop once it reaches a terminal state. A deleted source queue
+		// shows up here as the task's FailureReason instead of a hang.
+		if m.redrivePolling {
+			for _, task := range msg.tasks {
+				if task.TaskHandle != m.activeRedriveHandle {
+					continue
+				}
+				switch task.Status {
+				case "RUNNING":
+					progress := fmt.Sprintf("%d", task.MovedCount)
+					if task.TotalCount > 0 {
+						progress += fmt.Sprintf("/%d", task.TotalCount)
+					}
+					m.logger.Info("Redrive progress: %s messages moved", progress)
+					return m, m.redrivePollTick()
+				case "FAILED":
+					m.logger.Error("Redrive failed: %s", task.FailureReason)
+					m.state.ShowLogs = true
+					m.updateComponentSizes()
+				case "COMPLETED":
+					m.logger.Info("Redrive completed: %d messages moved", task.MovedCount)
+				default:
+					m.logger.Info("Redrive %s after %d messages", strings.ToLower(task.Status), task.MovedCount)
+				}
+				break
+			}
+			m.redrivePolling = false
+			return m, nil
+		}
+
+		if len(msg.tasks) == 0 {
+			m.logger.Info("No message move tasks found")
+			return m, nil
+		}
+		for _, task := range msg.tasks {
+			line := fmt.Sprintf("Move task %s: %d", task.Status, task.MovedCount)
+			if task.TotalCount > 0 {
+				line += fmt.Sprintf("/%d", task.TotalCount)
+			}
+			line += " messages moved"
+			if task.FailureReason != "" {
+				line += " (" + task.FailureReason + ")"
+			}
+			m.logger.Info("%s", line)
+		}
+		m.state.ShowLogs = true
+		m.updateComponentSizes()
+
+	case stackCountsMsg:
+		if m.stackCounts == nil {
+			m.stackCounts = make(map[string]aws.StackResourceCounts)
+		}
+		m.stackCounts[msg.stack] = msg.counts
+		if m.state.View == state.ViewStacks {
+			m.updateStacksList()
+		}
+		return m, m.continueStackCounts()
+
+	case ssoLoginFinishedMsg:
+		if cmd := m.handleSSOLoginFinished(msg); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+
+	case fnTagsMsg:
+		if msg.gen != m.loadGeneration {
+			// Stale stream from before a profile/region switch - keep
+			// draining so the channel gets released
+			return m, m.continueFunctionTags()
+		}
+		for i := range m.state.Functions {
+			if m.state.Functions[i].Name == msg.name {
+				m.state.Functions[i].Tags = msg.tags
+				break
+			}
+		}
+		if m.state.View == state.ViewLambda && m.state.LambdaGrouping == state.LambdaGroupStack {
+			m.updateLambdaList()
+		}
+		return m, m.continueFunctionTags()
+
+	case tagsLoadedMsg:
+		if cmd := m.handleTagsLoaded(msg); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+
+	case tagsSavedMsg:
+		m.handleTagsSaved(msg)
+
+	case fnCodeDownloadedMsg:
+		m.handleFnCodeDownloaded(msg)
+
+	case fnURLLoadedMsg:
+		m.handleFnURLLoaded(msg)
+
+	case stackTreeLoadedMsg:
+		m.handleStackTreeLoaded(msg)
+
+	case stackInfoMsg:
+		m.handleStackInfo(msg)
+
+	case stackEventsMsg:
+		m.handleStackEvents(msg)
+
+	case stackDriftMsg:
+		m.handleStackDrift(msg)
+
+	case ssoClientRebuiltMsg:
+		if msg.err != nil {
+			m.logger.Error("Failed to rebuild AWS client after login: %v", msg.err)
+			return m, nil
+		}
+		m.client = msg.client
+		m.logger.Info("Credentials refreshed - retrying load")
+		return m, m.handleRefresh()
+
+	case ctxSwitchPreviewMsg:
+		m.ctxSwitchLoading = false
+		if !m.ctxSwitchActive {
+			return m, nil // overlay dismissed while the preview was in flight
+		}
+		if msg.err != nil {
+			m.ctxSwitchErr = msg.err
+			if aws.IsSSOTokenExpired(msg.err) {
+				m.ctxSwitchErr = fmt.Errorf("SSO session expired - run: aws sso login --profile %s", m.ctxSwitchProfile)
+			}
+			return m, nil
+		}
+		m.ctxSwitchClient = msg.client
+		m.ctxSwitchAccount = msg.account
+
+	case queueMetricsMsg:
+		if msg.err != nil {
+			m.logger.Debug("Failed to fetch depth history for %s: %v", msg.queue, msg.err)
+			return m, nil
+		}
+		if m.queueMetrics == nil {
+			m.queueMetrics = make(map[string]queueMetricsEntry)
+		}
+		m.queueMetrics[msg.queue] = queueMetricsEntry{points: msg.points, fetchedAt: time.Now()}
+		if m.state.View == state.ViewSQS {
+			m.updateQueueDetails()
+		}
+
+	case exactCountMsg:
+		if msg.err != nil {
+			m.logger.Error("Exact count failed for %s: %v", msg.table, msg.err)
+			return m, nil
+		}
+		if m.exactCounts == nil {
+			m.exactCounts = make(map[string]int64)
+		}
+		m.exactCounts[msg.table] = msg.count
+		m.logger.Info("Exact count for %s: %d items (took %s)", msg.table, msg.count, msg.elapsed.Round(time.Millisecond))
+		if m.state.View == state.ViewDynamoDB {
+			m.updateTableDetails()
+		}
+
+	case alarmsLoadedMsg:
+		if msg.err != nil {
+			m.logger.Error("Failed to load alarms: %v", msg.err)
+			return m, nil
+		}
+		if m.resourceAlarms == nil {
+			m.resourceAlarms = make(map[string][]model.Alarm)
+		}
+		m.resourceAlarms[msg.key] = msg.alarms
+		if len(msg.alarms) == 0 {
+			m.logger.Info("No alarms configured on %s", msg.key)
+		} else {
+			m.logger.Info("Loaded %d alarm(s) for %s", len(msg.alarms), msg.key)
+		}
+		switch m.state.View {
+		case state.ViewSQS:
+			m.updateQueueDetails()
+		case state.ViewLambda:
+			m.updateLambdaDetails()
+		}
+
+	case regionLatencyMsg:
+		if msg.err != nil {
+			m.logger.Debug("Latency probe for %s failed: %v", msg.region, msg.err)
+			return m, nil
+		}
+		if m.regionLatencies == nil {
+			m.regionLatencies = make(map[string]time.Duration)
+		}
+		m.regionLatencies[msg.region] = msg.latency
+		m.regionSelector.SetLatency(msg.region, msg.latency)
+		m.logger.Debug("%s: %dms", msg.region, msg.latency.Milliseconds())
+
+	case preflightResultMsg:
+		if !msg.ok {
+			// Simulation unavailable - fall back silently to attempting the action
+			m.logger.Debug("Permission preflight unavailable for %s", msg.action)
+		} else if !msg.allowed {
+			m.preflightWarning = fmt.Sprintf("%s is likely to be DENIED", msg.action)
+			m.logger.Warn("Preflight: %s on %s is likely to be denied", msg.action, msg.resource)
+		}
+
+	case insightsResultMsg:
+		m.handleInsightsResult(msg)
+
+	case esmToggledMsg:
+		if msg.err != nil {
+			m.logger.Error("Failed to toggle mapping: %v", msg.err)
+			m.state.ShowLogs = true
+			m.updateComponentSizes()
+			return m, nil
+		}
+		// Show the transitional state and poll until it settles
+		transitional := "Disabling"
+		if msg.enabled {
+			transitional = "Enabling"
+		}
+		m.applyESMState(msg.function, msg.uuid, transitional)
+		m.esmPollFn = msg.function
+		m.esmPollUUID = msg.uuid
+		m.esmPollCount = 0
+		return m, m.esmPollTick()
+
+	case esmPollTickMsg:
+		if m.esmPollUUID != "" {
+			return m, m.pollESMState()
+		}
+
+	case esmStateMsg:
+		if m.esmPollUUID != msg.uuid {
+			return m, nil // a newer toggle superseded this poll
+		}
+		if msg.err != nil {
+			m.logger.Warn("Failed to poll mapping state: %v", msg.err)
+			m.esmPollUUID = ""
+			return m, nil
+		}
+		m.applyESMState(msg.function, msg.uuid, msg.state)
+		switch msg.state {
+		case "Enabled", "Disabled":
+			m.logger.Info("Trigger is now %s", msg.state)
+			m.esmPollUUID = ""
+		default:
+			m.esmPollCount++
+			if m.esmPollCount >= esmMaxPolls {
+				m.logger.Warn("Gave up waiting for the mapping to settle (still %s)", msg.state)
+				m.esmPollUUID = ""
+				return m, nil
+			}
+			return m, m.esmPollTick()
+		}
+
+	case mappingsLoadedMsg:
+		if msg.err != nil {
+			m.logger.Error("Failed to load mappings for %s: %v", msg.function, msg.err)
+			return m, nil
+		}
+		if m.fnMappings == nil {
+			m.fnMappings = make(map[string][]model.EventSourceMapping)
+		}
+		m.fnMappings[msg.function] = msg.mappings
+		m.logger.Info("Loaded %d event source mapping(s) for %s", len(msg.mappings), msg.function)
+		if m.state.View == state.ViewLambda {
+			m.updateLambdaDetails()
+		}
+
+	case aliasesLoadedMsg:
+		m.handleAliasesLoaded(msg)
+
+	case asyncInvokedMsg:
+		if msg.err != nil {
+			m.logger.Error("Async invocation of %s failed: %v", msg.name, msg.err)
+			m.state.ShowLogs = true
+			m.updateComponentSizes()
+			return m, nil
+		}
+		m.logger.Info("%s accepted for async execution (202) - check CloudWatch logs ('L') for output", msg.name)
+
+	case envUpdatedMsg:
+		m.handleEnvUpdated(msg)
+
+	case fnConfigUpdatedMsg:
+		m.handleFnConfigUpdated(msg)
+
 	case lambdaInvocationResultMsg:
 		m.state.LambdaInvocationLoading = false
 		if msg.err != nil {
@@ -989,6 +1980,9 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.logger.Info("Lambda %s invoked successfully (Status: %d, Duration: %v)",
 					msg.result.FunctionName, msg.result.StatusCode, msg.result.Duration)
 			}
+			if msg.result.ResponsePath != "" {
+				m.logger.Info("Response written to %s", msg.result.ResponsePath)
+			}
 		}
 		m.updateLambdaDetails()
 
@@ -2,15 +2,23 @@ package ui
 
 import (
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 
+	"vaws/internal/aws"
+	"vaws/internal/config"
+	"vaws/internal/model"
 	"vaws/internal/state"
 	"vaws/internal/ui/components"
 	"vaws/internal/ui/theme"
 )
 
+// lambdaGroupIDPrefix marks list items that are collapsible group headers.
+const lambdaGroupIDPrefix = "group:"
+
 // updateComponentSizes updates the sizes of all UI components based on terminal dimensions.
 func (m *Model) updateComponentSizes() {
 	if !m.ready {
@@ -33,32 +41,70 @@ func (m *Model) updateQuickBarActions() {
 	var actions []components.QuickKey
 
 	switch m.state.View {
+	case state.ViewStacks:
+		actions = []components.QuickKey{
+			{Key: "O", Label: "outputs"},
+			{Key: "Y", Label: "copy outputs"},
+			{Key: "v", Label: "events"},
+			{Key: "V", Label: "drift"},
+		}
 	case state.ViewServices:
 		actions = []components.QuickKey{
 			{Key: "p", Label: "port-forward"},
 			{Key: "l", Label: "logs"},
+			{Key: "s", Label: "shell"},
+			{Key: "d", Label: "deploy"},
+			{Key: "D", Label: "task def"},
+			{Key: "v", Label: "events"},
+			{Key: "w", Label: "watch scaling"},
 		}
 	case state.ViewAPIStages:
 		actions = []components.QuickKey{
 			{Key: "p", Label: "port-forward"},
+			{Key: "e", Label: "export json"},
+			{Key: "E", Label: "export yaml"},
+			{Key: "V", Label: "stage var"},
 		}
 	case state.ViewLambda:
 		actions = []components.QuickKey{
 			{Key: "i", Label: "invoke"},
 			{Key: "l", Label: "logs"},
+			{Key: "o", Label: "group"},
+			{Key: "v", Label: "vpc filter"},
+			{Key: "A", Label: "alarms"},
+			{Key: "z", Label: "aliases"},
+			{Key: "T", Label: "tags"},
+			{Key: "w", Label: "download code"},
+			{Key: "U", Label: "function url"},
 		}
 	case state.ViewTunnels:
 		actions = []components.QuickKey{
 			{Key: "p", Label: "new tunnel"},
 			{Key: "s", Label: "stop"},
 			{Key: "r", Label: "restart"},
+			{Key: "i", Label: "send request"},
+			{Key: "B", Label: "restore"},
+			{Key: "Y", Label: "copy address"},
+			{Key: "C", Label: "copy endpoints"},
 		}
 	case state.ViewSQS:
-		// No special actions for SQS list
+		actions = []components.QuickKey{
+			{Key: "m", Label: "send message"},
+			{Key: "P", Label: "purge"},
+			{Key: "D", Label: "redrive DLQ"},
+			{Key: "M", Label: "move status"},
+			{Key: "X", Label: "cancel move"},
+			{Key: "A", Label: "alarms"},
+			{Key: "T", Label: "tags"},
+		}
 	case state.ViewDynamoDB:
 		actions = []components.QuickKey{
 			{Key: "q", Label: "query"},
 			{Key: "s", Label: "scan"},
+			{Key: "T", Label: "templates"},
+			{Key: "Q", Label: "partiql"},
+			{Key: "+", Label: "new item"},
+			{Key: "#", Label: "exact count"},
 		}
 	case state.ViewDynamoDBQuery:
 		actions = []components.QuickKey{
@@ -69,11 +115,17 @@ func (m *Model) updateQuickBarActions() {
 			{Key: "C-d/u", Label: "half page"},
 			{Key: "y", Label: "copy"},
 			{Key: "Y", Label: "yank"},
+			{Key: "c", Label: "put-item cmd"},
+			{Key: "e", Label: "edit item"},
+			{Key: "x", Label: "delete item"},
 			{Key: "r", Label: "refresh"},
 		}
 	case state.ViewCloudWatchLogs:
 		actions = []components.QuickKey{
 			{Key: "Tab", Label: "switch container"},
+			{Key: "I", Label: "insights"},
+			{Key: "/", Label: "filter"},
+			{Key: "F", Label: "server filter"},
 		}
 	}
 
@@ -170,9 +222,46 @@ func (m *Model) updateMainMenuList() {
 	})
 }
 
+// changeHighlightTTL returns how long refresh-diff highlights stay visible.
+func (m *Model) changeHighlightTTL() time.Duration {
+	if m.cfg != nil {
+		return m.cfg.ChangeHighlightTTL()
+	}
+	return config.DefaultChangeHighlightTTL
+}
+
+// removedGhostItems builds faded list rows for recently removed keys.
+// Ghosts are skipped while filtering so they never fight the filter results.
+func (m *Model) removedGhostItems(changes map[string]state.RowChange) []components.ListItem {
+	if m.state.FilterText != "" {
+		return nil
+	}
+	ttl := m.changeHighlightTTL()
+	var names []string
+	for name, ch := range changes {
+		if ch.Kind == state.ChangeRemoved && !ch.Expired(ttl) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	removedStyle := lipgloss.NewStyle().Foreground(theme.Error)
+	items := make([]components.ListItem, len(names))
+	for i, name := range names {
+		items[i] = components.ListItem{
+			ID:          "removed:" + name,
+			Title:       name,
+			Status:      "removed",
+			StatusStyle: removedStyle,
+		}
+	}
+	return items
+}
+
 // updateStacksList updates the stacks list with current data.
 func (m *Model) updateStacksList() {
 	stacks := m.state.FilteredStacks()
+	ttl := m.changeHighlightTTL()
 	items := make([]components.ListItem, len(stacks))
 	for i, s := range stacks {
 		items[i] = components.ListItem{
@@ -181,13 +270,48 @@ func (m *Model) updateStacksList() {
 			Status:      string(s.Status),
 			StatusStyle: StatusStyle(string(s.Status)),
 		}
+		// Lazily computed resource-count badge (when enabled)
+		if counts, ok := m.stackCounts[s.Name]; ok {
+			if badge := stackBadge(counts); badge != "" {
+				items[i].Title = s.Name + " " + badge
+			}
+		}
+		if ch, ok := m.state.StackChanges[s.Name]; ok && !ch.Expired(ttl) {
+			switch ch.Kind {
+			case state.ChangeAdded:
+				items[i].Status = "+ " + items[i].Status
+				items[i].StatusStyle = lipgloss.NewStyle().Foreground(theme.Success)
+			case state.ChangeUpdated:
+				items[i].StatusStyle = lipgloss.NewStyle().Foreground(theme.Warning)
+			}
+		}
 	}
+	items = append(items, m.removedGhostItems(m.state.StackChanges)...)
+	m.stacksList.SetHighlight(m.state.FilterText)
 	m.stacksList.SetItems(items)
 	m.stacksList.SetLoading(false)
 	m.stacksList.SetError(m.state.StacksError)
 	m.updateStackDetails()
 }
 
+// stackBadge formats non-zero resource counts as a compact badge.
+func stackBadge(c aws.StackResourceCounts) string {
+	var parts []string
+	if c.Functions > 0 {
+		parts = append(parts, fmt.Sprintf("%dλ", c.Functions))
+	}
+	if c.Queues > 0 {
+		parts = append(parts, fmt.Sprintf("%dsqs", c.Queues))
+	}
+	if c.Tables > 0 {
+		parts = append(parts, fmt.Sprintf("%dddb", c.Tables))
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return "[" + strings.Join(parts, " ") + "]"
+}
+
 // updateClustersList updates the clusters list with current data.
 func (m *Model) updateClustersList() {
 	clusters := m.state.FilteredClusters()
@@ -242,6 +366,13 @@ func (m *Model) updateStackResourcesList() {
 			Status:      "📨",
 			StatusStyle: lipgloss.NewStyle().Foreground(theme.Info),
 		},
+		{
+			ID:          "all-resources",
+			Title:       "All Resources",
+			Description: "Every resource in the stack, grouped by type",
+			Status:      "🌳",
+			StatusStyle: lipgloss.NewStyle().Foreground(theme.TextMuted),
+		},
 	}
 	m.stackResourcesList.SetItems(items)
 	m.stackResourcesList.SetLoading(false)
@@ -264,6 +395,7 @@ func (m *Model) updateStackResourcesList() {
 // updateServicesList updates the services list with current data.
 func (m *Model) updateServicesList() {
 	services := m.state.FilteredServices()
+	ttl := m.changeHighlightTTL()
 	items := make([]components.ListItem, len(services))
 	for i, s := range services {
 		items[i] = components.ListItem{
@@ -273,7 +405,22 @@ func (m *Model) updateServicesList() {
 			StatusStyle: ServiceStatusStyle(s.RunningCount, s.DesiredCount),
 			Extra:       s.ClusterName,
 		}
+		if ch, ok := m.state.ServiceChanges[s.Name]; ok && !ch.Expired(ttl) {
+			switch ch.Kind {
+			case state.ChangeAdded:
+				items[i].Status = "+ " + items[i].Status
+				items[i].StatusStyle = lipgloss.NewStyle().Foreground(theme.Success)
+			case state.ChangeUpdated:
+				arrow := "↑"
+				if ch.Delta < 0 {
+					arrow = "↓"
+				}
+				items[i].Status = fmt.Sprintf("%s %s%+d", items[i].Status, arrow, ch.Delta)
+				items[i].StatusStyle = lipgloss.NewStyle().Foreground(theme.Warning)
+			}
+		}
 	}
+	items = append(items, m.removedGhostItems(m.state.ServiceChanges)...)
 	m.serviceList.SetItems(items)
 	m.serviceList.SetLoading(false)
 	m.serviceList.SetError(m.state.ServicesError)
@@ -284,16 +431,22 @@ func (m *Model) updateServicesList() {
 // updateLambdaList updates the Lambda functions list with current data.
 func (m *Model) updateLambdaList() {
 	functions := m.state.FilteredFunctions()
-	items := make([]components.ListItem, len(functions))
-	for i, fn := range functions {
-		items[i] = components.ListItem{
-			ID:          fn.Name,
-			Title:       fn.Name,
-			Status:      string(fn.State),
-			StatusStyle: FunctionStatusStyle(fn.State),
-			Extra:       fn.Runtime,
+	var items []components.ListItem
+	if m.state.LambdaGrouping == state.LambdaGroupNone {
+		items = make([]components.ListItem, len(functions))
+		for i, fn := range functions {
+			items[i] = components.ListItem{
+				ID:          fn.Name,
+				Title:       lambdaTitle(&fn),
+				Status:      string(fn.State),
+				StatusStyle: FunctionStatusStyle(fn.State),
+				Extra:       fn.Runtime,
+			}
 		}
+	} else {
+		items = m.groupedLambdaItems(functions)
 	}
+	m.lambdaList.SetHighlight(m.state.FilterText)
 	m.lambdaList.SetItems(items)
 	m.lambdaList.SetLoading(false)
 	m.lambdaList.SetError(m.state.FunctionsError)
@@ -301,6 +454,74 @@ func (m *Model) updateLambdaList() {
 	m.updateLambdaDetails()
 }
 
+// lambdaTitle renders a function name with a small VPC badge when attached.
+func lambdaTitle(fn *model.Function) string {
+	if !fn.InVPC() {
+		return fn.Name
+	}
+	return fmt.Sprintf("%s [vpc %dsn/%dsg]", fn.Name, len(fn.VpcConfig.SubnetIDs), len(fn.VpcConfig.SecurityGroupIDs))
+}
+
+// lambdaGroupKey returns the grouping key for a function under the current grouping mode.
+func (m *Model) lambdaGroupKey(fn *model.Function) string {
+	switch m.state.LambdaGrouping {
+	case state.LambdaGroupStack:
+		if stack := fn.StackName(); stack != "" {
+			return stack
+		}
+		return "(no stack)"
+	case state.LambdaGroupPrefix:
+		return fn.NamePrefix("-")
+	}
+	return ""
+}
+
+// groupedLambdaItems builds list items with collapsible group headers.
+// Collapsed groups show only the header; Enter/space on a header toggles it.
+func (m *Model) groupedLambdaItems(functions []model.Function) []components.ListItem {
+	groups := make(map[string][]model.Function)
+	for i := range functions {
+		key := m.lambdaGroupKey(&functions[i])
+		groups[key] = append(groups[key], functions[i])
+	}
+
+	keys := make([]string, 0, len(groups))
+	for key := range groups {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	headerStyle := lipgloss.NewStyle().Foreground(theme.Primary).Bold(true)
+
+	var items []components.ListItem
+	for _, key := range keys {
+		collapsed := m.state.LambdaCollapsedGroups[key]
+		marker := "▾"
+		if collapsed {
+			marker = "▸"
+		}
+		items = append(items, components.ListItem{
+			ID:          lambdaGroupIDPrefix + key,
+			Title:       fmt.Sprintf("%s %s", marker, key),
+			Status:      fmt.Sprintf("%d", len(groups[key])),
+			StatusStyle: headerStyle,
+		})
+		if collapsed {
+			continue
+		}
+		for _, fn := range groups[key] {
+			items = append(items, components.ListItem{
+				ID:          fn.Name,
+				Title:       "  " + lambdaTitle(&fn),
+				Status:      string(fn.State),
+				StatusStyle: FunctionStatusStyle(fn.State),
+				Extra:       fn.Runtime,
+			})
+		}
+	}
+	return items
+}
+
 // updateAPIGatewayList updates the API Gateway list with current data.
 func (m *Model) updateAPIGatewayList() {
 	// Combine REST and HTTP APIs into a single list
@@ -368,6 +589,26 @@ func (m *Model) updateAPIStagesList() {
 	m.updateAPIStageDetails()
 }
 
+// updateAPIRoutesList updates the routes list with current data. WebSocket
+// APIs surface their $connect/$disconnect/$default and custom route keys.
+func (m *Model) updateAPIRoutesList() {
+	routes := m.state.FilteredAPIRoutes()
+	items := make([]components.ListItem, len(routes))
+	for i, route := range routes {
+		items[i] = components.ListItem{
+			ID:          route.RouteID,
+			Title:       route.RouteKey,
+			Status:      route.AuthType,
+			StatusStyle: lipgloss.NewStyle().Foreground(theme.Info),
+			Extra:       route.Target,
+		}
+	}
+	m.apiRoutesList.SetItems(items)
+	m.apiRoutesList.SetLoading(false)
+	m.apiRoutesList.SetError(m.state.APIRoutesError)
+	m.apiRoutesList.SetEmptyMessage("No routes defined for this API")
+}
+
 // updateEC2List updates the EC2 instances list for jump host selection.
 func (m *Model) updateEC2List() {
 	instances := m.state.FilteredEC2Instances()
@@ -386,16 +627,44 @@ func (m *Model) updateEC2List() {
 
 		items[i] = components.ListItem{
 			ID:          inst.InstanceID,
-			Title:       inst.Name,
+			Title:       inst.Name + ec2TagBadge(inst.Tags),
 			Status:      vpcShort,
 			StatusStyle: statusStyle,
 			Extra:       inst.PrivateIPAddress,
 		}
+		// Transitional lifecycle state takes over the status column
+		if transition, ok := m.ec2States[inst.InstanceID]; ok {
+			items[i].Status = transition
+			items[i].StatusStyle = lipgloss.NewStyle().Foreground(theme.Warning)
+		}
 	}
 	m.ec2List.SetItems(items)
 	m.ec2List.SetLoading(false)
 	m.ec2List.SetError(m.state.EC2InstancesError)
 	m.ec2List.SetEmptyMessage("No SSM-managed EC2 instances found")
+	m.updateJumpHostDetails()
+}
+
+// ec2TagBadge renders up to two non-Name tags as a compact row badge.
+func ec2TagBadge(tags map[string]string) string {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		if k != "Name" {
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) == 0 {
+		return ""
+	}
+	sort.Strings(keys)
+	if len(keys) > 2 {
+		keys = keys[:2]
+	}
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		parts[i] = k + "=" + tags[k]
+	}
+	return " [" + strings.Join(parts, " ") + "]"
 }
 
 // updateContainerList updates the container list for container selection.
@@ -436,7 +705,18 @@ func (m *Model) updateContainerList() {
 // updateQueuesList updates the SQS queues list with current data.
 func (m *Model) updateQueuesList() {
 	queues := m.state.FilteredQueues()
+	m.sqsTable.SetHighlight(m.state.FilterText)
 	m.sqsTable.SetQueues(queues)
+
+	// Pass still-fresh message-count deltas for arrow display
+	ttl := m.changeHighlightTTL()
+	deltas := make(map[string]int)
+	for name, ch := range m.state.QueueChanges {
+		if ch.Kind == state.ChangeUpdated && !ch.Expired(ttl) {
+			deltas[name] = ch.Delta
+		}
+	}
+	m.sqsTable.SetMessageDeltas(deltas)
 	m.sqsTable.SetLoading(false)
 	m.sqsTable.SetError(m.state.QueuesError)
 	m.updateQueueDetails()
@@ -460,16 +740,22 @@ func (m *Model) updateCurrentList() {
 		m.updateStacksList()
 	case state.ViewStackResources:
 		m.updateStackResourcesList()
+	case state.ViewStackTree:
+		m.updateStackTreeList()
 	case state.ViewClusters:
 		m.updateClustersList()
 	case state.ViewServices:
 		m.updateServicesList()
+	case state.ViewTasks:
+		m.updateTasksList()
 	case state.ViewLambda:
 		m.updateLambdaList()
 	case state.ViewAPIGateway:
 		m.updateAPIGatewayList()
 	case state.ViewAPIStages:
 		m.updateAPIStagesList()
+	case state.ViewAPIRoutes:
+		m.updateAPIRoutesList()
 	case state.ViewJumpHostSelect:
 		m.updateEC2List()
 	case state.ViewContainerSelect:
@@ -481,10 +767,21 @@ func (m *Model) updateCurrentList() {
 	}
 }
 
+// defaultRulesSuffix annotates a view title when default filter rules hid rows.
+func (m *Model) defaultRulesSuffix(key string) string {
+	if m.state.DefaultFiltersOff {
+		return ""
+	}
+	if hidden := m.state.DefaultHidden[key]; hidden > 0 {
+		return fmt.Sprintf(" (default rules: %d hidden)", hidden)
+	}
+	return ""
+}
+
 // updateContainerContext sets the container's title and context based on current view.
 func (m *Model) updateContainerContext() {
 	region := m.state.Region
-	m.container.SetContext(region)
+	m.container.SetContext(region + m.autoRefreshSuffix())
 	// Don't use Container's loading/error - Lists handle their own states
 	m.container.SetLoading(false)
 	m.container.SetError(nil)
@@ -494,12 +791,12 @@ func (m *Model) updateContainerContext() {
 		m.container.SetTitle("Main Menu")
 		m.container.SetItemCount(0)
 	case state.ViewStacks:
-		m.container.SetTitle("CloudFormation Stacks")
 		if m.state.StacksLoading {
 			m.container.SetItemCount(0)
 		} else {
 			m.container.SetItemCount(len(m.state.FilteredStacks()))
 		}
+		m.container.SetTitle("CloudFormation Stacks" + m.defaultRulesSuffix("stacks"))
 	case state.ViewStackResources:
 		title := "Stack Resources"
 		if m.state.SelectedStack != nil {
@@ -519,19 +816,26 @@ func (m *Model) updateContainerContext() {
 		if m.state.SelectedCluster != nil {
 			title = "ECS: " + m.state.SelectedCluster.Name
 		}
-		m.container.SetTitle(title)
 		if m.state.ServicesLoading {
 			m.container.SetItemCount(0)
 		} else {
 			m.container.SetItemCount(len(m.state.FilteredServices()))
 		}
+		m.container.SetTitle(title + m.defaultRulesSuffix("ecs"))
+	case state.ViewTasks:
+		title := "ECS Tasks"
+		if m.state.SelectedService != nil {
+			title = "Tasks: " + m.state.SelectedService.Name
+		}
+		m.container.SetTitle(title)
+		m.container.SetItemCount(len(m.state.FilteredTasks()))
 	case state.ViewLambda:
-		m.container.SetTitle("Lambda Functions")
 		if m.state.FunctionsLoading {
 			m.container.SetItemCount(0)
 		} else {
 			m.container.SetItemCount(len(m.state.FilteredFunctions()))
 		}
+		m.container.SetTitle("Lambda Functions" + m.defaultRulesSuffix("lambda"))
 	case state.ViewAPIGateway:
 		m.container.SetTitle("API Gateway")
 		if m.state.APIsLoading {
@@ -540,6 +844,13 @@ func (m *Model) updateContainerContext() {
 			total := len(m.state.FilteredRestAPIs()) + len(m.state.FilteredHttpAPIs())
 			m.container.SetItemCount(total)
 		}
+	case state.ViewAPIRoutes:
+		title := "API Routes"
+		if m.state.SelectedHttpAPI != nil {
+			title = "Routes: " + m.state.SelectedHttpAPI.Name
+		}
+		m.container.SetTitle(title)
+		m.container.SetItemCount(len(m.state.FilteredAPIRoutes()))
 	case state.ViewAPIStages:
 		title := "API Stages"
 		if m.state.SelectedRestAPI != nil {
@@ -550,25 +861,45 @@ func (m *Model) updateContainerContext() {
 		m.container.SetTitle(title)
 		m.container.SetItemCount(len(m.state.APIStages))
 	case state.ViewSQS:
-		m.container.SetTitle("SQS Queues")
 		if m.state.QueuesLoading {
 			m.container.SetItemCount(0)
 		} else {
 			m.container.SetItemCount(len(m.state.FilteredQueues()))
 		}
+		m.container.SetTitle("SQS Queues" + m.defaultRulesSuffix("sqs"))
 	case state.ViewDynamoDB:
-		m.container.SetTitle("DynamoDB Tables")
 		if m.state.TablesLoading {
 			m.container.SetItemCount(0)
 		} else {
 			m.container.SetItemCount(len(m.state.FilteredTables()))
 		}
+		m.container.SetTitle("DynamoDB Tables" + m.defaultRulesSuffix("dynamodb"))
 	case state.ViewJumpHostSelect:
 		m.container.SetTitle("Select Jump Host")
 		m.container.SetItemCount(len(m.state.EC2Instances))
 	case state.ViewContainerSelect:
 		m.container.SetTitle("Select Container")
 		m.container.SetItemCount(len(m.state.PendingContainers))
+	case state.ViewGlobalSearch:
+		m.container.SetTitle("Search: " + m.globalSearchQuery)
+		m.container.SetItemCount(len(m.globalSearchResults))
+	case state.ViewLambdaAliases:
+		m.container.SetTitle("Aliases: " + m.aliasFn)
+		m.container.SetItemCount(len(m.fnAliases) + len(m.fnVersions))
+	case state.ViewSQSMessages:
+		title := "Peeked Messages"
+		if m.state.SelectedQueue != nil {
+			title = "Messages: " + m.state.SelectedQueue.Name
+		}
+		m.container.SetTitle(title)
+		m.container.SetItemCount(len(m.state.PeekedMessages))
+	case state.ViewQueryTemplates:
+		title := "Query Templates"
+		if m.state.SelectedTable != nil {
+			title = "Templates: " + m.state.SelectedTable.Name
+		}
+		m.container.SetTitle(title)
+		m.container.SetItemCount(len(m.queryTemplates))
 	case state.ViewTunnels:
 		m.container.SetTitle("Active Tunnels")
 		m.container.SetItemCount(len(m.tunnelManager.GetTunnels()))
@@ -32,6 +32,20 @@ func (m *Model) View() string {
 		return "Initializing..."
 	}
 
+	// Idle lock screen - any key unlocks
+	if m.idleLocked {
+		lockStyle := lipgloss.NewStyle().
+			Foreground(theme.Warning).
+			Bold(true)
+		return lipgloss.Place(
+			m.width,
+			m.height,
+			lipgloss.Center,
+			lipgloss.Center,
+			lockStyle.Render("vaws locked after idle timeout - press any key"),
+		)
+	}
+
 	// Show profile selection screen
 	if m.state.View == state.ViewProfileSelect {
 		m.profileSelector.SetSize(m.width, m.height)
@@ -92,6 +106,7 @@ func (m *Model) View() string {
 	m.statusBar.SetWidth(m.width)
 	m.statusBar.SetProfile(m.state.Profile)
 	m.statusBar.SetRegion(m.state.Region)
+	m.statusBar.SetLastRefresh(m.refreshIndicator.LastRefresh())
 	m.statusBar.SetActiveTunnels(len(m.tunnelManager.GetTunnels()))
 	header := m.statusBar.View()
 
@@ -147,6 +162,12 @@ func (m *Model) View() string {
 	if m.filtering {
 		m.quickBar.SetMode("filter")
 		m.quickBar.SetFilterText(m.filterInput.Value())
+	} else if m.cwFiltering {
+		m.quickBar.SetMode("filter")
+		m.quickBar.SetFilterText(m.cwFilterInput.Value())
+	} else if m.cwPatternEditing {
+		m.quickBar.SetMode("filter")
+		m.quickBar.SetFilterText(m.cwPatternInput.Value())
 	} else if m.detailsSearching {
 		m.quickBar.SetMode("search")
 		m.quickBar.SetFilterText(m.detailsSearchInput.Value())
@@ -174,6 +195,95 @@ func (m *Model) View() string {
 		// Center the payload input dialog inside container
 		m.container.SetContent(lipgloss.Place(m.container.ContentWidth(), m.container.ContentHeight(), lipgloss.Center, lipgloss.Center, payloadInputView))
 		sections = append(sections, m.container.View())
+	} else if m.esmToggleActive {
+		// Center the mapping toggle prompt inside container
+		m.container.SetContent(lipgloss.Place(m.container.ContentWidth(), m.container.ContentHeight(), lipgloss.Center, lipgloss.Center, m.renderESMToggleDialog()))
+		sections = append(sections, m.container.View())
+	} else if m.lambdaEditStage != lambdaEditStageNone {
+		// Center the Lambda config edit form inside container
+		m.container.SetContent(lipgloss.Place(m.container.ContentWidth(), m.container.ContentHeight(), lipgloss.Center, lipgloss.Center, m.renderLambdaEditDialog()))
+		sections = append(sections, m.container.View())
+	} else if m.ec2ActionActive {
+		// Center the instance action picker inside container
+		m.container.SetContent(lipgloss.Place(m.container.ContentWidth(), m.container.ContentHeight(), lipgloss.Center, lipgloss.Center, m.renderEC2ActionDialog()))
+		sections = append(sections, m.container.View())
+	} else if m.stageVarActive {
+		// Center the stage variable prompt inside container
+		m.container.SetContent(lipgloss.Place(m.container.ContentWidth(), m.container.ContentHeight(), lipgloss.Center, lipgloss.Center, m.renderStageVarDialog()))
+		sections = append(sections, m.container.View())
+	} else if m.tunnelReqStage != tunnelReqStageNone {
+		// Center the tunnel request builder inside container
+		m.container.SetContent(lipgloss.Place(m.container.ContentWidth(), m.container.ContentHeight(), lipgloss.Center, lipgloss.Center, m.renderTunnelReqDialog()))
+		sections = append(sections, m.container.View())
+	} else if m.putItemGuardActive {
+		// Center the overwrite-guard prompt inside container
+		m.container.SetContent(lipgloss.Place(m.container.ContentWidth(), m.container.ContentHeight(), lipgloss.Center, lipgloss.Center, m.renderPutItemGuardDialog()))
+		sections = append(sections, m.container.View())
+	} else if m.indexPickActive {
+		// Center the query index picker inside container
+		m.container.SetContent(lipgloss.Place(m.container.ContentWidth(), m.container.ContentHeight(), lipgloss.Center, lipgloss.Center, m.renderIndexPickDialog()))
+		sections = append(sections, m.container.View())
+	} else if m.itemDeleteConfirmActive {
+		// Center the item delete confirmation inside container
+		m.container.SetContent(lipgloss.Place(m.container.ContentWidth(), m.container.ContentHeight(), lipgloss.Center, lipgloss.Center, m.renderItemDeleteConfirmDialog()))
+		sections = append(sections, m.container.View())
+	} else if m.deleteMsgConfirmActive {
+		// Center the message delete confirmation inside container
+		m.container.SetContent(lipgloss.Place(m.container.ContentWidth(), m.container.ContentHeight(), lipgloss.Center, lipgloss.Center, m.renderDeleteMsgConfirmDialog()))
+		sections = append(sections, m.container.View())
+	} else if m.purgeConfirmActive {
+		// Center the purge confirmation inside container
+		m.container.SetContent(lipgloss.Place(m.container.ContentWidth(), m.container.ContentHeight(), lipgloss.Center, lipgloss.Center, m.renderPurgeConfirmDialog()))
+		sections = append(sections, m.container.View())
+	} else if m.structuredEditor.IsActive() {
+		// Center the structured editor inside container
+		m.structuredEditor.SetSize(m.container.ContentWidth(), m.container.ContentHeight())
+		m.container.SetContent(lipgloss.Place(m.container.ContentWidth(), m.container.ContentHeight(), lipgloss.Center, lipgloss.Center, m.structuredEditor.View()))
+		sections = append(sections, m.container.View())
+	} else if m.sendMsgStage != sendMsgStageNone {
+		// Center the FIFO send prompt inside container
+		m.container.SetContent(lipgloss.Place(m.container.ContentWidth(), m.container.ContentHeight(), lipgloss.Center, lipgloss.Center, m.renderSendMsgPromptDialog()))
+		sections = append(sections, m.container.View())
+	} else if m.scanConfirmActive {
+		// Center the scan cost warning inside container
+		m.container.SetContent(lipgloss.Place(m.container.ContentWidth(), m.container.ContentHeight(), lipgloss.Center, lipgloss.Center, m.renderScanConfirmDialog()))
+		sections = append(sections, m.container.View())
+	} else if m.outputRouteActive {
+		// Center the output destination chooser inside container
+		m.container.SetContent(lipgloss.Place(m.container.ContentWidth(), m.container.ContentHeight(), lipgloss.Center, lipgloss.Center, m.renderOutputRouteDialog()))
+		sections = append(sections, m.container.View())
+	} else if m.forceDeployConfirmActive {
+		// Center the force-deployment confirmation inside container
+		m.container.SetContent(lipgloss.Place(m.container.ContentWidth(), m.container.ContentHeight(), lipgloss.Center, lipgloss.Center, m.renderForceDeployConfirmDialog()))
+		sections = append(sections, m.container.View())
+	} else if m.scaleWatchActive {
+		// Center the scaling-progress overlay inside container
+		m.container.SetContent(lipgloss.Place(m.container.ContentWidth(), m.container.ContentHeight(), lipgloss.Center, lipgloss.Center, m.renderScaleWatchDialog()))
+		sections = append(sections, m.container.View())
+	} else if m.redriveActive {
+		// Center the redrive preview dialog inside container
+		m.container.SetContent(lipgloss.Place(m.container.ContentWidth(), m.container.ContentHeight(), lipgloss.Center, lipgloss.Center, m.renderRedriveDialog()))
+		sections = append(sections, m.container.View())
+	} else if m.ctxSwitchActive {
+		// Center the context switcher overlay inside container
+		m.container.SetContent(lipgloss.Place(m.container.ContentWidth(), m.container.ContentHeight(), lipgloss.Center, lipgloss.Center, m.renderContextSwitchDialog()))
+		sections = append(sections, m.container.View())
+	} else if m.enteringTemplateParam {
+		// Center the template placeholder prompt inside container
+		m.container.SetContent(lipgloss.Place(m.container.ContentWidth(), m.container.ContentHeight(), lipgloss.Center, lipgloss.Center, m.renderTemplateParamDialog()))
+		sections = append(sections, m.container.View())
+	} else if m.globalSearching {
+		// Center the global search dialog inside container
+		m.container.SetContent(lipgloss.Place(m.container.ContentWidth(), m.container.ContentHeight(), lipgloss.Center, lipgloss.Center, m.renderGlobalSearchDialog()))
+		sections = append(sections, m.container.View())
+	} else if m.ssoLoginActive {
+		// Center the SSO re-auth modal inside container
+		m.container.SetContent(lipgloss.Place(m.container.ContentWidth(), m.container.ContentHeight(), lipgloss.Center, lipgloss.Center, m.renderSSOLoginDialog()))
+		sections = append(sections, m.container.View())
+	} else if m.helpActive {
+		// Center the help modal inside container
+		m.container.SetContent(lipgloss.Place(m.container.ContentWidth(), m.container.ContentHeight(), lipgloss.Center, lipgloss.Center, m.renderHelpDialog()))
+		sections = append(sections, m.container.View())
 	} else if m.dynamodbQueryDialog.IsActive() {
 		// Center the DynamoDB query dialog inside container
 		m.dynamodbQueryDialog.SetSize(m.container.ContentWidth(), m.container.ContentHeight())
@@ -248,11 +358,18 @@ func (m *Model) renderMainContent(layout layoutMode, contentHeight int) string {
 	m.stackResourcesList.SetSize(listWidth, contentHeight)
 	m.clustersList.SetSize(listWidth, contentHeight)
 	m.serviceList.SetSize(listWidth, contentHeight)
+	m.tasksList.SetSize(listWidth, contentHeight)
 	m.lambdaList.SetSize(listWidth, contentHeight)
 	m.apiGatewayList.SetSize(listWidth, contentHeight)
 	m.apiStagesList.SetSize(listWidth, contentHeight)
+	m.apiRoutesList.SetSize(listWidth, contentHeight)
 	m.ec2List.SetSize(listWidth, contentHeight)
 	m.containerList.SetSize(listWidth, contentHeight)
+	m.globalSearchList.SetSize(listWidth, contentHeight)
+	m.templatesList.SetSize(listWidth, contentHeight)
+	m.peekList.SetSize(listWidth, contentHeight)
+	m.aliasList.SetSize(listWidth, contentHeight)
+	m.stackTreeList.SetSize(listWidth, contentHeight)
 	m.sqsTable.SetSize(listWidth, contentHeight)
 	m.dynamodbTable.SetSize(listWidth, contentHeight)
 	if layout != layoutSingle {
@@ -272,16 +389,30 @@ func (m *Model) renderMainContent(layout layoutMode, contentHeight int) string {
 		listView = m.clustersList.View()
 	case state.ViewServices:
 		listView = m.serviceList.View()
+	case state.ViewTasks:
+		listView = m.tasksList.View()
 	case state.ViewLambda:
 		listView = m.lambdaList.View()
 	case state.ViewAPIGateway:
 		listView = m.apiGatewayList.View()
 	case state.ViewAPIStages:
 		listView = m.apiStagesList.View()
+	case state.ViewAPIRoutes:
+		listView = m.apiRoutesList.View()
 	case state.ViewJumpHostSelect:
 		listView = m.ec2List.View()
 	case state.ViewContainerSelect:
 		listView = m.containerList.View()
+	case state.ViewGlobalSearch:
+		listView = m.globalSearchList.View()
+	case state.ViewQueryTemplates:
+		listView = m.templatesList.View()
+	case state.ViewSQSMessages:
+		listView = m.peekList.View()
+	case state.ViewLambdaAliases:
+		listView = m.aliasList.View()
+	case state.ViewStackTree:
+		listView = m.stackTreeList.View()
 	case state.ViewSQS:
 		listView = m.sqsTable.View()
 	case state.ViewDynamoDB:
@@ -409,9 +540,24 @@ func (m *Model) renderPayloadDialog() string {
 		fnName = truncateString(m.pendingInvokeFunction.Name, dialogWidth-20)
 	}
 
+	mode := "Request/Response"
+	if m.invokeAsync {
+		mode = "Event (async)"
+	}
+	modeStyle := lipgloss.NewStyle().Foreground(theme.Info)
+
 	dialogContent := labelStyle.Render("Invoke Lambda: "+fnName) + "\n\n" +
-		"Payload (JSON): " + m.payloadInput.View() + "\n\n" +
-		hintStyle.Render("Enter JSON payload or press Enter for empty")
+		"Payload (JSON): " + m.payloadInput.View() + "\n" +
+		modeStyle.Render("Mode: "+mode) + "\n\n" +
+		hintStyle.Render("Enter JSON payload, file://path, or press Enter for empty • Tab switches mode")
+
+	// Permission preflight warning (when enabled and a denial is predicted)
+	if m.preflightWarning != "" {
+		warnStyle := lipgloss.NewStyle().
+			Foreground(theme.Warning).
+			Bold(true)
+		dialogContent += "\n\n" + warnStyle.Render("⚠ "+m.preflightWarning)
+	}
 
 	return dialogStyle.Render(dialogContent)
 }